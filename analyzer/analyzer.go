@@ -0,0 +1,252 @@
+package analyzer
+
+import (
+	"database/sql"
+	"fmt"
+	"go/ast"
+	"go/types"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+
+	"github.com/ArtyomViryutin/validator"
+)
+
+var Analyzer = &analysis.Analyzer{
+	Name:     "validatetag",
+	Doc:      "check that validate struct tags are well-formed and match their field types",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+var (
+	tagName     string
+	customRules string
+)
+
+func init() {
+	Analyzer.Flags.StringVar(&tagName, "tag", "validate", "struct tag holding validation rules")
+	Analyzer.Flags.StringVar(&customRules, "rules", "", "comma-separated names of custom rules registered at runtime")
+}
+
+var knownTypes = map[string]reflect.Type{
+	"time.Time":                reflect.TypeOf(time.Time{}),
+	"time.Duration":            reflect.TypeOf(time.Duration(0)),
+	"database/sql.NullString":  reflect.TypeOf(sql.NullString{}),
+	"database/sql.NullInt64":   reflect.TypeOf(sql.NullInt64{}),
+	"database/sql.NullInt32":   reflect.TypeOf(sql.NullInt32{}),
+	"database/sql.NullInt16":   reflect.TypeOf(sql.NullInt16{}),
+	"database/sql.NullByte":    reflect.TypeOf(sql.NullByte{}),
+	"database/sql.NullFloat64": reflect.TypeOf(sql.NullFloat64{}),
+	"database/sql.NullBool":    reflect.TypeOf(sql.NullBool{}),
+	"database/sql.NullTime":    reflect.TypeOf(sql.NullTime{}),
+}
+
+var basicTypes = map[types.BasicKind]reflect.Type{
+	types.Bool:          reflect.TypeOf(false),
+	types.Int:           reflect.TypeOf(int(0)),
+	types.Int8:          reflect.TypeOf(int8(0)),
+	types.Int16:         reflect.TypeOf(int16(0)),
+	types.Int32:         reflect.TypeOf(int32(0)),
+	types.Int64:         reflect.TypeOf(int64(0)),
+	types.Uint:          reflect.TypeOf(uint(0)),
+	types.Uint8:         reflect.TypeOf(uint8(0)),
+	types.Uint16:        reflect.TypeOf(uint16(0)),
+	types.Uint32:        reflect.TypeOf(uint32(0)),
+	types.Uint64:        reflect.TypeOf(uint64(0)),
+	types.Uintptr:       reflect.TypeOf(uintptr(0)),
+	types.Float32:       reflect.TypeOf(float32(0)),
+	types.Float64:       reflect.TypeOf(float64(0)),
+	types.Complex64:     reflect.TypeOf(complex64(0)),
+	types.Complex128:    reflect.TypeOf(complex128(0)),
+	types.String:        reflect.TypeOf(""),
+	types.UnsafePointer: reflect.TypeOf(uintptr(0)),
+}
+
+func newValidator() (*validator.Validator, error) {
+	val := validator.New(validator.WithTagName(tagName))
+	if len(customRules) == 0 {
+		return val, nil
+	}
+	kinds := make([]reflect.Kind, 0, reflect.UnsafePointer)
+	for k := reflect.Bool; k <= reflect.UnsafePointer; k++ {
+		kinds = append(kinds, k)
+	}
+	noop := func(reflect.Value, string) error { return nil }
+	for _, name := range strings.Split(customRules, ",") {
+		if err := val.RegisterValidation(strings.TrimSpace(name), noop, kinds...); err != nil {
+			return nil, err
+		}
+	}
+	return val, nil
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	val, err := newValidator()
+	if err != nil {
+		return nil, err
+	}
+	insp := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	insp.Preorder([]ast.Node{(*ast.StructType)(nil)}, func(n ast.Node) {
+		node := n.(*ast.StructType)
+		st, ok := pass.TypesInfo.TypeOf(node).(*types.Struct)
+		if !ok {
+			return
+		}
+		checkStruct(pass, val, node, st)
+	})
+	return nil, nil
+}
+
+func checkStruct(pass *analysis.Pass, val *validator.Validator, node *ast.StructType, st *types.Struct) {
+	var parent reflect.Type
+	var indexes []int
+	i := 0
+	for _, field := range node.Fields.List {
+		n := len(field.Names)
+		if n == 0 {
+			n = 1
+		}
+		for j := 0; j < n; j, i = j+1, i+1 {
+			if field.Tag == nil {
+				continue
+			}
+			raw, err := strconv.Unquote(field.Tag.Value)
+			if err != nil {
+				continue
+			}
+			tag, ok := reflect.StructTag(raw).Lookup(tagName)
+			if !ok {
+				continue
+			}
+			f := st.Field(i)
+			if !f.Exported() {
+				pass.Reportf(field.Tag.Pos(), "%s tag on unexported field %s is not allowed", tagName, f.Name())
+				continue
+			}
+			if parent == nil {
+				if parent, indexes = reflectStruct(st); parent == nil {
+					return
+				}
+			}
+			if indexes[i] < 0 {
+				continue
+			}
+			if err := val.CheckTag(parent, indexes[i], tag); err != nil {
+				pass.Reportf(field.Tag.Pos(), "%s", describe(pass, f, tag, err))
+			}
+		}
+	}
+}
+
+func describe(pass *analysis.Pass, f *types.Var, tag string, err error) string {
+	ruleErr, ok := err.(validator.RuleError)
+	if !ok {
+		return fmt.Sprintf("invalid %s tag %q: %v", tagName, tag, err)
+	}
+	switch ruleErr.Err {
+	case validator.ErrUnknownRule:
+		return fmt.Sprintf("unknown rule %q in %s tag", ruleErr.Rule.Name, tagName)
+	case validator.ErrRuleNotApplicable:
+		typ := types.TypeString(f.Type(), types.RelativeTo(pass.Pkg))
+		return fmt.Sprintf("rule %q is not applicable to field %s of type %s", ruleErr.Rule.Name, f.Name(), typ)
+	}
+	return fmt.Sprintf("invalid rule %q in %s tag", ruleErr.Rule.String(), tagName)
+}
+
+func reflectStruct(st *types.Struct) (reflect.Type, []int) {
+	fields := make([]reflect.StructField, 0, st.NumFields())
+	indexes := make([]int, st.NumFields())
+	for i := 0; i < st.NumFields(); i++ {
+		indexes[i] = -1
+		f := st.Field(i)
+		if !f.Exported() {
+			continue
+		}
+		t, ok := reflectType(f.Type(), make(map[*types.Named]bool))
+		if !ok {
+			continue
+		}
+		indexes[i] = len(fields)
+		fields = append(fields, reflect.StructField{Name: f.Name(), Type: t})
+	}
+	return reflect.StructOf(fields), indexes
+}
+
+func isTimeStruct(t types.Type) bool {
+	st, ok := t.(*types.Struct)
+	if !ok || st.NumFields() != 3 {
+		return false
+	}
+	ptr, ok := st.Field(2).Type().(*types.Pointer)
+	if !ok {
+		return false
+	}
+	loc, ok := ptr.Elem().(*types.Named)
+	return ok && loc.Obj().Pkg() != nil && loc.Obj().Pkg().Path() == "time" && loc.Obj().Name() == "Location"
+}
+
+func reflectType(t types.Type, visiting map[*types.Named]bool) (reflect.Type, bool) {
+	switch t := t.(type) {
+	case *types.Named:
+		if obj := t.Obj(); obj.Pkg() != nil {
+			if known, ok := knownTypes[obj.Pkg().Path()+"."+obj.Name()]; ok {
+				return known, true
+			}
+		}
+		if isTimeStruct(t.Underlying()) {
+			return knownTypes["time.Time"], true
+		}
+		if visiting[t] {
+			return nil, false
+		}
+		visiting[t] = true
+		defer delete(visiting, t)
+		return reflectType(t.Underlying(), visiting)
+	case *types.Basic:
+		rt, ok := basicTypes[t.Kind()]
+		return rt, ok
+	case *types.Pointer:
+		elem, ok := reflectType(t.Elem(), visiting)
+		if !ok {
+			return nil, false
+		}
+		return reflect.PointerTo(elem), true
+	case *types.Slice:
+		elem, ok := reflectType(t.Elem(), visiting)
+		if !ok {
+			return nil, false
+		}
+		return reflect.SliceOf(elem), true
+	case *types.Array:
+		elem, ok := reflectType(t.Elem(), visiting)
+		if !ok {
+			return nil, false
+		}
+		return reflect.ArrayOf(int(t.Len()), elem), true
+	case *types.Map:
+		key, ok := reflectType(t.Key(), visiting)
+		if !ok || !key.Comparable() {
+			return nil, false
+		}
+		elem, ok := reflectType(t.Elem(), visiting)
+		if !ok {
+			return nil, false
+		}
+		return reflect.MapOf(key, elem), true
+	case *types.Struct:
+		return reflect.TypeOf(struct{}{}), true
+	case *types.Interface:
+		return reflect.TypeOf((*any)(nil)).Elem(), true
+	case *types.Chan:
+		return reflect.TypeOf((chan struct{})(nil)), true
+	case *types.Signature:
+		return reflect.TypeOf((func())(nil)), true
+	}
+	return nil, false
+}
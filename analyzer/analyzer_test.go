@@ -0,0 +1,41 @@
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestAnalyzer(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), Analyzer, "a")
+}
+
+func TestAnalyzerFlags(t *testing.T) {
+	tests := []struct {
+		name  string
+		flags map[string]string
+		pkg   string
+	}{
+		{
+			name:  "custom rules",
+			flags: map[string]string{"rules": "sku"},
+			pkg:   "b",
+		},
+		{
+			name:  "tag name",
+			flags: map[string]string{"rules": "sku", "tag": "check"},
+			pkg:   "c",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for name, value := range tt.flags {
+				old := Analyzer.Flags.Lookup(name).Value.String()
+				require.NoError(t, Analyzer.Flags.Set(name, value))
+				t.Cleanup(func() { _ = Analyzer.Flags.Set(name, old) })
+			}
+			analysistest.Run(t, analysistest.TestData(), Analyzer, tt.pkg)
+		})
+	}
+}
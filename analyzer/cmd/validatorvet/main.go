@@ -0,0 +1,11 @@
+package main
+
+import (
+	"golang.org/x/tools/go/analysis/singlechecker"
+
+	"github.com/ArtyomViryutin/validator/analyzer"
+)
+
+func main() {
+	singlechecker.Main(analyzer.Analyzer)
+}
@@ -0,0 +1,20 @@
+module github.com/ArtyomViryutin/validator/analyzer
+
+go 1.22.0
+
+require (
+	github.com/ArtyomViryutin/validator v0.0.0-00010101000000-000000000000
+	github.com/stretchr/testify v1.8.2
+	golang.org/x/tools v0.30.0
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/pkg/errors v0.9.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	golang.org/x/mod v0.23.0 // indirect
+	golang.org/x/sync v0.11.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
+replace github.com/ArtyomViryutin/validator => ../
@@ -0,0 +1,38 @@
+package a
+
+import "time"
+
+type Status string
+
+type Timestamp time.Time
+
+type Stamped struct {
+	At      Timestamp  `validate:"required;after:2020-01-01T00:00:00Z"`
+	Expires *Timestamp `validate:"required;before:2100-01-01T00:00:00Z"`
+	Bad     Timestamp  `validate:"len:3"` // want `rule "len" is not applicable to field Bad of type Timestamp`
+}
+
+type Address struct {
+	City string `validate:"required"`
+}
+
+type User struct {
+	Name      string            `validate:"required;min:2"`
+	Code      string            `validate:"len:abcdef"` // want `invalid rule "len:abcdef" in validate tag`
+	Age       int               `validate:"len:3"`      // want `rule "len" is not applicable to field Age of type int`
+	Email     string            `validate:"emial"`      // want `unknown rule "emial" in validate tag`
+	Role      Status            `validate:"in:admin,user"`
+	Tags      []string          `validate:"maxitems:3;dive;min:1"`
+	Labels    map[string]string `validate:"dive;max:8"`
+	Created   time.Time         `validate:"required"`
+	Timeout   time.Duration     `validate:"min:1s"`
+	Password  string            `validate:"required"`
+	Confirm   string            `validate:"eqfield:Password"`
+	Other     string            `validate:"eqfield:Missing"`    // want `invalid rule "eqfield:Missing" in validate tag`
+	Home      Address           `validate:"required"`           // want `rule "required" is not applicable to field Home of type Address`
+	Flags     []int             `validate:"dive"`               // want `invalid rule "dive" in validate tag`
+	Broken    string            `validate:";"`                  // want `invalid validate tag ";": invalid validator syntax`
+	Both      string            `validate:"omitempty;required"` // want `invalid rule "omitempty" in validate tag`
+	secret    string            `validate:"required"`           // want `validate tag on unexported field secret is not allowed`
+	Untouched int
+}
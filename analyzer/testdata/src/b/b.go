@@ -0,0 +1,6 @@
+package b
+
+type Article struct {
+	SKU   string `validate:"sku"`
+	Title string `validate:"titlecase"` // want `unknown rule "titlecase" in validate tag`
+}
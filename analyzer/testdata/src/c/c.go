@@ -0,0 +1,7 @@
+package c
+
+type Article struct {
+	SKU   string `check:"required;sku"`
+	Title string `validate:"titlecase"`
+	Lead  string `check:"lede"` // want `unknown rule "lede" in check tag`
+}
@@ -0,0 +1,906 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ArtyomViryutin/validator"
+)
+
+const (
+	validatorImport = "github.com/ArtyomViryutin/validator"
+	errorsImport    = "github.com/pkg/errors"
+)
+
+type kind int
+
+const (
+	kindOther kind = iota
+	kindString
+	kindInt
+	kindUint
+	kindFloat
+	kindBool
+	kindStruct
+)
+
+var builtinKinds = map[string]kind{
+	"string":  kindString,
+	"int":     kindInt,
+	"int8":    kindInt,
+	"int16":   kindInt,
+	"int32":   kindInt,
+	"int64":   kindInt,
+	"rune":    kindInt,
+	"uint":    kindUint,
+	"uint8":   kindUint,
+	"uint16":  kindUint,
+	"uint32":  kindUint,
+	"uint64":  kindUint,
+	"uintptr": kindUint,
+	"byte":    kindUint,
+	"float32": kindFloat,
+	"float64": kindFloat,
+	"bool":    kindBool,
+}
+
+type fieldType struct {
+	kind  kind
+	name  string
+	base  string
+	ptr   bool
+	slice bool
+}
+
+func (t fieldType) elem() fieldType {
+	t.slice = false
+	return t
+}
+
+type field struct {
+	name      string
+	expr      ast.Expr
+	typ       fieldType
+	known     bool
+	tagged    bool
+	exported  bool
+	embedded  bool
+	rules     []validator.Rule
+	omitEmpty bool
+	dive      bool
+	elemRules []validator.Rule
+	elemOmit  bool
+}
+
+type structType struct {
+	name   string
+	fields []field
+}
+
+type generator struct {
+	pkg     string
+	decls   map[string]ast.Expr
+	structs map[string]*structType
+	needs   map[string]bool
+	hooks   map[string]bool
+	buf     bytes.Buffer
+	imports map[string]bool
+	join    bool
+}
+
+func generate(dir string, names []string, output string) ([]byte, error) {
+	g := &generator{
+		decls:   make(map[string]ast.Expr),
+		structs: make(map[string]*structType),
+		hooks:   make(map[string]bool),
+		imports: map[string]bool{validatorImport: true},
+	}
+	if err := g.parse(dir, output); err != nil {
+		return nil, err
+	}
+	g.resolveNeeds()
+	if len(names) == 0 {
+		for name := range g.structs {
+			if g.needs[name] {
+				names = append(names, name)
+			}
+		}
+	}
+	emit, err := g.closure(names)
+	if err != nil {
+		return nil, err
+	}
+	var body bytes.Buffer
+	for _, name := range emit {
+		g.buf.Reset()
+		if err := g.emitStruct(g.structs[name]); err != nil {
+			return nil, err
+		}
+		body.Write(g.buf.Bytes())
+	}
+	return g.file(body.Bytes())
+}
+
+func (g *generator) parse(dir, output string) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	fset := token.NewFileSet()
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") || name == output {
+			continue
+		}
+		file, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.SkipObjectResolution)
+		if err != nil {
+			return err
+		}
+		g.pkg = file.Name.Name
+		for _, decl := range file.Decls {
+			if fn, ok := decl.(*ast.FuncDecl); ok && fn.Recv != nil && fn.Name.Name == "ValidateStruct" {
+				g.hooks[embeddedName(fn.Recv.List[0].Type)] = true
+				continue
+			}
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				spec := spec.(*ast.TypeSpec)
+				if spec.TypeParams == nil {
+					g.decls[spec.Name.Name] = spec.Type
+				}
+			}
+		}
+	}
+	if len(g.pkg) == 0 {
+		return fmt.Errorf("no Go files in %s", dir)
+	}
+	for name, expr := range g.decls {
+		st, ok := expr.(*ast.StructType)
+		if !ok {
+			continue
+		}
+		s, err := g.parseStruct(name, st)
+		if err != nil {
+			return err
+		}
+		g.structs[name] = s
+	}
+	return nil
+}
+
+func (g *generator) parseStruct(name string, st *ast.StructType) (*structType, error) {
+	s := &structType{name: name}
+	for _, f := range st.Fields.List {
+		var tag string
+		var tagged bool
+		if f.Tag != nil {
+			raw, err := strconv.Unquote(f.Tag.Value)
+			if err != nil {
+				return nil, fmt.Errorf("%s: malformed tag %s", name, f.Tag.Value)
+			}
+			tag, tagged = reflect.StructTag(raw).Lookup("validate")
+		}
+		typ, known := g.resolve(f.Type)
+		names := f.Names
+		if len(names) == 0 {
+			names = []*ast.Ident{ast.NewIdent(embeddedName(f.Type))}
+		}
+		for _, ident := range names {
+			fl := field{
+				name:     ident.Name,
+				expr:     f.Type,
+				typ:      typ,
+				known:    known,
+				tagged:   tagged,
+				exported: ast.IsExported(ident.Name),
+				embedded: len(f.Names) == 0,
+			}
+			if tagged {
+				if err := fl.parseTag(tag); err != nil {
+					return nil, fmt.Errorf("%s.%s: %v", name, ident.Name, err)
+				}
+			}
+			s.fields = append(s.fields, fl)
+		}
+	}
+	return s, nil
+}
+
+func embeddedName(expr ast.Expr) string {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return e.Name
+	case *ast.StarExpr:
+		return embeddedName(e.X)
+	case *ast.SelectorExpr:
+		return e.Sel.Name
+	}
+	return ""
+}
+
+func (g *generator) resolve(expr ast.Expr) (fieldType, bool) {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		if k, ok := builtinKinds[e.Name]; ok {
+			return fieldType{kind: k, name: e.Name, base: e.Name}, true
+		}
+		switch decl := g.decls[e.Name].(type) {
+		case *ast.StructType:
+			return fieldType{kind: kindStruct, name: e.Name}, true
+		case *ast.Ident:
+			if typ, ok := g.resolve(decl); ok && typ.kind != kindStruct {
+				typ.name = e.Name
+				return typ, true
+			}
+		}
+	case *ast.StarExpr:
+		if typ, ok := g.resolve(e.X); ok && !typ.ptr && !typ.slice {
+			typ.ptr = true
+			return typ, true
+		}
+	case *ast.ArrayType:
+		if typ, ok := g.resolve(e.Elt); ok && e.Len == nil && !typ.ptr && !typ.slice && typ.base != "uint8" && typ.base != "byte" {
+			typ.slice = true
+			return typ, true
+		}
+	}
+	return fieldType{}, false
+}
+
+func (f *field) parseTag(tag string) error {
+	rules, err := validator.ParseRules(tag)
+	if err != nil {
+		return fmt.Errorf("invalid tag %q", tag)
+	}
+	var elemRules []validator.Rule
+	for i, r := range rules {
+		if r.Name == "dive" {
+			if len(r.Param) != 0 {
+				return fmt.Errorf("invalid rule %q", r)
+			}
+			rules, elemRules, f.dive = rules[:i], rules[i+1:], true
+			break
+		}
+	}
+	if f.rules, f.omitEmpty, err = splitOmitEmpty(rules); err != nil {
+		return err
+	}
+	if !f.dive {
+		return nil
+	}
+	if len(elemRules) == 0 {
+		return fmt.Errorf("dive requires element rules")
+	}
+	for _, r := range elemRules {
+		if r.Name == "dive" {
+			return fmt.Errorf("nested dive is not supported")
+		}
+	}
+	f.elemRules, f.elemOmit, err = splitOmitEmpty(elemRules)
+	return err
+}
+
+func splitOmitEmpty(rules []validator.Rule) ([]validator.Rule, bool, error) {
+	var omitEmpty, required bool
+	kept := make([]validator.Rule, 0, len(rules))
+	for _, r := range rules {
+		if r.Name == "omitempty" {
+			if len(r.Param) != 0 || omitEmpty {
+				return nil, false, fmt.Errorf("invalid rule %q", r)
+			}
+			omitEmpty = true
+			continue
+		}
+		required = required || r.Name == "required"
+		kept = append(kept, r)
+	}
+	if omitEmpty && required {
+		return nil, false, fmt.Errorf("omitempty conflicts with required")
+	}
+	return kept, omitEmpty, nil
+}
+
+func (g *generator) resolveNeeds() {
+	g.needs = make(map[string]bool)
+	for changed := true; changed; {
+		changed = false
+		for name, s := range g.structs {
+			if g.needs[name] {
+				continue
+			}
+			if g.hasHook(name) {
+				g.needs[name], changed = true, true
+				continue
+			}
+			for _, f := range s.fields {
+				if f.tagged || f.known && f.typ.kind == kindStruct && g.needs[f.typ.name] || !f.known && g.refersToNeeded(f.expr) {
+					g.needs[name], changed = true, true
+					break
+				}
+			}
+		}
+	}
+}
+
+func (g *generator) hasHook(name string) bool {
+	return g.promotesHook(name, make(map[string]bool))
+}
+
+func (g *generator) promotesHook(name string, seen map[string]bool) bool {
+	if g.hooks[name] {
+		return true
+	}
+	s, ok := g.structs[name]
+	if !ok || seen[name] {
+		return false
+	}
+	seen[name] = true
+	for _, f := range s.fields {
+		if f.embedded && f.known && f.typ.kind == kindStruct && !f.typ.slice && g.promotesHook(f.typ.name, seen) {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *generator) closure(names []string) ([]string, error) {
+	seen := make(map[string]bool)
+	var visit func(name string) error
+	visit = func(name string) error {
+		if seen[name] {
+			return nil
+		}
+		s, ok := g.structs[name]
+		if !ok {
+			return fmt.Errorf("%s is not a struct type in package %s", name, g.pkg)
+		}
+		seen[name] = true
+		for _, f := range s.fields {
+			if f.known && f.typ.kind == kindStruct && g.needs[f.typ.name] {
+				if err := visit(f.typ.name); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+	for _, name := range names {
+		if err := visit(strings.TrimSpace(name)); err != nil {
+			return nil, err
+		}
+	}
+	emit := make([]string, 0, len(seen))
+	for name := range seen {
+		emit = append(emit, name)
+	}
+	sort.Strings(emit)
+	return emit, nil
+}
+
+func (g *generator) printf(format string, args ...any) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+func (g *generator) emitStruct(s *structType) error {
+	name := exportedName(s.name)
+	g.printf("func Validate%s(v %s) error {\n", name, s.name)
+	g.printf("if errs := validate%s(v); len(errs) != 0 {\nreturn errs\n}\nreturn nil\n}\n\n", name)
+	g.printf("func validate%s(v %s) validator.ValidationErrors {\n", name, s.name)
+	if g.hasHook(s.name) {
+		g.printf("errs := validate%sFields(v)\n", name)
+		g.printf("switch err := (&v).ValidateStruct().(type) {\ncase nil:\n")
+		g.printf("case validator.ValidationErrors:\nerrs = append(errs, err...)\n")
+		g.printf("case validator.ValidationError:\nerrs = append(errs, err)\n")
+		g.printf("default:\nerrs = append(errs, validator.ValidationError{Err: err})\n}\n")
+		g.printf("return errs\n}\n\n")
+		g.printf("func validate%sFields(v %s) validator.ValidationErrors {\n", name, s.name)
+	}
+	g.printf("var errs validator.ValidationErrors\n")
+	for _, f := range s.fields {
+		if err := g.emitField(f); err != nil {
+			return fmt.Errorf("%s.%s: %v", s.name, f.name, err)
+		}
+	}
+	g.printf("return errs\n}\n\n")
+	return nil
+}
+
+func exportedName(name string) string {
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+func (g *generator) emitField(f field) error {
+	nested := f.known && f.typ.kind == kindStruct && g.needs[f.typ.name]
+	if f.embedded && f.known && f.typ.kind == kindStruct && !f.typ.slice {
+		if nested {
+			g.emitEmbedded(f)
+		}
+		return nil
+	}
+	if !f.tagged && !nested {
+		if !f.known && g.refersToNeeded(f.expr) {
+			return fmt.Errorf("field type is not supported")
+		}
+		return nil
+	}
+	switch {
+	case f.embedded:
+		return fmt.Errorf("embedded non-struct fields are not supported")
+	case !f.exported:
+		return fmt.Errorf("unexported fields cannot be validated")
+	case !f.known:
+		return fmt.Errorf("field type is not supported")
+	}
+	expr := "v." + f.name
+	if len(f.rules) != 0 || f.dive {
+		if f.omitEmpty {
+			g.printf("if %s {\n", g.nonZero(expr, f.typ))
+		}
+		for _, r := range f.rules {
+			if err := g.emitRule(f, expr, r); err != nil {
+				return err
+			}
+		}
+		if f.dive {
+			if err := g.emitDive(f, expr); err != nil {
+				return err
+			}
+		}
+		if f.omitEmpty {
+			g.printf("}\n")
+		}
+	}
+	if !nested {
+		return nil
+	}
+	validate := "validate" + exportedName(f.typ.name)
+	switch {
+	case f.typ.slice:
+		g.imports["fmt"] = true
+		g.printf("for i, elem := range %s {\n", expr)
+		g.emitNested(f.typ.name, validate+"(elem)", fmt.Sprintf("fmt.Sprintf(%q, i)", f.name+"[%d]"))
+		g.printf("}\n")
+	case f.typ.ptr:
+		g.printf("if %s != nil {\n", expr)
+		g.emitNested(f.typ.name, validate+"(*"+expr+")", strconv.Quote(f.name))
+		g.printf("}\n")
+	default:
+		g.emitNested(f.typ.name, validate+"("+expr+")", strconv.Quote(f.name))
+	}
+	return nil
+}
+
+func (g *generator) emitNested(typ, call, parent string) {
+	g.printf("for _, err := range %s {\n", call)
+	if g.hasHook(typ) {
+		g.join = true
+		g.imports["strings"] = true
+		g.printf("err.Field = joinValidationField(%s, err.Field)\n", parent)
+	} else if strings.HasPrefix(parent, "fmt.Sprintf(") {
+		g.printf("err.Field = %s + err.Field\n", strings.Replace(parent, `]"`, `]."`, 1))
+	} else {
+		g.printf("err.Field = %s + err.Field\n", strconv.Quote(parent[1:len(parent)-1]+"."))
+	}
+	g.printf("errs = append(errs, err)\n}\n")
+}
+
+func (g *generator) emitEmbedded(f field) {
+	validate := "validate" + exportedName(f.typ.name)
+	if g.hasHook(f.typ.name) {
+		validate += "Fields"
+	}
+	if f.typ.ptr {
+		g.printf("if v.%s != nil {\nerrs = append(errs, %s(*v.%s)...)\n}\n", f.name, validate, f.name)
+		return
+	}
+	g.printf("errs = append(errs, %s(v.%s)...)\n", validate, f.name)
+}
+
+func (g *generator) emitDive(f field, expr string) error {
+	if !f.typ.slice {
+		return fmt.Errorf("dive requires a slice field")
+	}
+	if f.typ.kind == kindStruct {
+		return fmt.Errorf("dive is not supported on slices of structs")
+	}
+	elem := f.typ.elem()
+	checks := make([]check, 0, len(f.elemRules))
+	for _, r := range f.elemRules {
+		c, err := g.valueCheck(elem, "elem", r)
+		if err != nil {
+			return err
+		}
+		checks = append(checks, c)
+	}
+	g.imports["fmt"] = true
+	g.printf("for i, elem := range %s {\n", expr)
+	if f.elemOmit {
+		g.printf("if %s {\n", g.nonZero("elem", elem))
+	}
+	for i, c := range checks {
+		g.emitCheck(c, fmt.Sprintf("fmt.Sprintf(%q, i)", f.name+"[%d]"), "elem", f.elemRules[i])
+	}
+	if f.elemOmit {
+		g.printf("}\n")
+	}
+	g.printf("}\n")
+	return nil
+}
+
+func (g *generator) refersToNeeded(expr ast.Expr) bool {
+	found := false
+	ast.Inspect(expr, func(n ast.Node) bool {
+		if ident, ok := n.(*ast.Ident); ok && g.needs[ident.Name] {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+func (g *generator) nonZero(expr string, typ fieldType) string {
+	switch {
+	case typ.slice || typ.ptr:
+		return expr + " != nil"
+	case typ.kind == kindString:
+		return expr + ` != ""`
+	case typ.kind == kindBool:
+		return expr + " != false"
+	case typ.kind == kindFloat:
+		g.imports["math"] = true
+		return "math.Float64bits(float64(" + expr + ")) != 0"
+	}
+	return expr + " != 0"
+}
+
+type check struct {
+	cond   string
+	format string
+	args   []string
+}
+
+func (g *generator) emitRule(f field, expr string, r validator.Rule) error {
+	if f.typ.slice {
+		if c, ok, err := collectionCheck(expr, r, f.dive); ok || err != nil {
+			if err != nil {
+				return err
+			}
+			g.emitCheck(c, strconv.Quote(f.name), expr, r)
+			return nil
+		}
+		if f.dive {
+			return fmt.Errorf("rule %q is not supported before dive", r.Name)
+		}
+		if f.typ.kind == kindStruct {
+			return fmt.Errorf("rule %q is not supported on slices of structs", r.Name)
+		}
+		c, err := g.valueCheck(f.typ, "elem", r)
+		if err != nil {
+			return err
+		}
+		g.imports["fmt"] = true
+		g.printf("for i, elem := range %s {\n", expr)
+		g.emitCheck(c, fmt.Sprintf("fmt.Sprintf(%q, i)", f.name+"[%d]"), "elem", r)
+		g.printf("}\n")
+		return nil
+	}
+	if f.typ.ptr && r.Name == "required" {
+		if len(r.Param) != 0 {
+			return fmt.Errorf("invalid rule %q", r.Name)
+		}
+		g.emitCheck(check{expr + " == nil", "is required", nil}, strconv.Quote(f.name), "", r)
+		return nil
+	}
+	if f.typ.kind == kindStruct {
+		return fmt.Errorf("rule %q is not supported on struct fields", r.Name)
+	}
+	if f.typ.ptr {
+		c, err := g.valueCheck(f.typ, "*"+expr, r)
+		if err != nil {
+			return err
+		}
+		if f.omitEmpty {
+			g.emitCheck(c, strconv.Quote(f.name), "*"+expr, r)
+			return nil
+		}
+		g.printf("if %s != nil {\n", expr)
+		g.emitCheck(c, strconv.Quote(f.name), "*"+expr, r)
+		g.printf("}\n")
+		return nil
+	}
+	c, err := g.valueCheck(f.typ, expr, r)
+	if err != nil {
+		return err
+	}
+	g.emitCheck(c, strconv.Quote(f.name), expr, r)
+	return nil
+}
+
+func (g *generator) emitCheck(c check, fieldExpr, valueExpr string, r validator.Rule) {
+	errExpr := fmt.Sprintf("errors.New(%q)", c.format)
+	if len(c.args) != 0 {
+		g.imports["fmt"] = true
+		errExpr = fmt.Sprintf("fmt.Errorf(%q, %s)", c.format, strings.Join(c.args, ", "))
+	} else {
+		g.imports[errorsImport] = true
+	}
+	g.printf("if %s {\n", c.cond)
+	param := ""
+	if len(r.Param) != 0 {
+		param = fmt.Sprintf(" Param: %q,", r.Param)
+	}
+	value := ""
+	if len(valueExpr) != 0 {
+		g.imports["fmt"] = true
+		value = fmt.Sprintf(" Value: fmt.Sprint(%s),", valueExpr)
+	}
+	g.printf("errs = append(errs, validator.ValidationError{Field: %s, Rule: %q,%s%s Err: %s})\n",
+		fieldExpr, r.Name, param, value, errExpr)
+	g.printf("}\n")
+}
+
+func collectionCheck(expr string, r validator.Rule, dive bool) (check, bool, error) {
+	if r.Name == "required" {
+		if len(r.Param) != 0 {
+			return check{}, true, fmt.Errorf("invalid rule %q", r.Name)
+		}
+		return check{expr + " == nil", "is required", nil}, true, nil
+	}
+	name := r.Name
+	if dive {
+		switch name {
+		case "len":
+			name = "lenitems"
+		case "min":
+			name = "minitems"
+		case "max":
+			name = "maxitems"
+		}
+	}
+	var op, format string
+	switch name {
+	case "lenitems":
+		op, format = "!=", "number of items %d is not equal to %d"
+	case "minitems":
+		op, format = "<", "number of items %d is less than min allowed %d"
+	case "maxitems":
+		op, format = ">", "number of items %d is higher than max allowed %d"
+	default:
+		return check{}, false, nil
+	}
+	n, err := strconv.Atoi(r.Param)
+	if err != nil {
+		return check{}, true, fmt.Errorf("invalid %s parameter %q", r.Name, r.Param)
+	}
+	length := "len(" + expr + ")"
+	return check{fmt.Sprintf("%s %s %d", length, op, n), format, []string{length, strconv.Itoa(n)}}, true, nil
+}
+
+func (g *generator) valueCheck(typ fieldType, expr string, r validator.Rule) (check, error) {
+	switch typ.kind {
+	case kindString:
+		return stringCheck(expr, r)
+	case kindInt:
+		return numberCheck(expr, r, intNumber)
+	case kindUint:
+		return numberCheck(expr, r, uintNumber)
+	case kindFloat:
+		c, err := numberCheck(expr, r, floatNumber)
+		if err == nil && r.Name == "required" {
+			g.imports["math"] = true
+			c.cond = "math.Float64bits(float64(" + expr + ")) == 0"
+		}
+		return c, err
+	case kindBool:
+		return boolCheck(expr, r)
+	}
+	return check{}, fmt.Errorf("field type is not supported")
+}
+
+func stringCheck(expr string, r validator.Rule) (check, error) {
+	value := "string(" + expr + ")"
+	var op, format string
+	switch r.Name {
+	case "required":
+		if len(r.Param) != 0 {
+			return check{}, fmt.Errorf("invalid rule %q", r.Name)
+		}
+		return check{expr + ` == ""`, "is required", nil}, nil
+	case "in":
+		if len(r.Param) == 0 {
+			return check{}, fmt.Errorf("invalid rule %q", r.Name)
+		}
+		values := r.Params()
+		conds := make([]string, 0, len(values))
+		quoted := make([]string, 0, len(values))
+		for _, v := range values {
+			conds = append(conds, fmt.Sprintf("%s == %q", value, v))
+			quoted = append(quoted, strconv.Quote(v))
+		}
+		return check{
+			"!(" + strings.Join(conds, " || ") + ")",
+			"%s is not in %v",
+			[]string{value, "[]string{" + strings.Join(quoted, ", ") + "}"},
+		}, nil
+	case "len":
+		op, format = "!=", "len of %s is not equal to %d"
+	case "min":
+		op, format = "<", "len of %s is less than min allowed %d"
+	case "max":
+		op, format = ">", "len of %s is higher than max allowed %d"
+	default:
+		return check{}, fmt.Errorf("rule %q is not supported for strings", r.Name)
+	}
+	n, err := strconv.Atoi(r.Param)
+	if err != nil {
+		return check{}, fmt.Errorf("invalid %s parameter %q", r.Name, r.Param)
+	}
+	return check{fmt.Sprintf("len(%s) %s %d", expr, op, n), format, []string{value, strconv.Itoa(n)}}, nil
+}
+
+type number struct {
+	kind  string
+	conv  string
+	verb  string
+	parse func(string) (string, bool)
+}
+
+var (
+	intNumber = number{"integers", "int64", "%d", func(s string) (string, bool) {
+		n, err := strconv.ParseInt(s, 10, 64)
+		return strconv.FormatInt(n, 10), err == nil
+	}}
+	uintNumber = number{"unsigned integers", "uint64", "%d", func(s string) (string, bool) {
+		n, err := strconv.ParseUint(s, 10, 64)
+		return strconv.FormatUint(n, 10), err == nil
+	}}
+	floatNumber = number{"floats", "float64", "%g", func(s string) (string, bool) {
+		n, err := strconv.ParseFloat(s, 64)
+		if err != nil || math.IsInf(n, 0) || math.IsNaN(n) || n == 0 && math.Signbit(n) {
+			return "", false
+		}
+		return strconv.FormatFloat(n, 'g', -1, 64), true
+	}}
+)
+
+func numberCheck(expr string, r validator.Rule, n number) (check, error) {
+	value := n.conv + "(" + expr + ")"
+	var op, format string
+	switch r.Name {
+	case "required":
+		if len(r.Param) != 0 {
+			return check{}, fmt.Errorf("invalid rule %q", r.Name)
+		}
+		return check{expr + " == 0", "is required", nil}, nil
+	case "in":
+		params := strings.Split(r.Param, ",")
+		conds := make([]string, 0, len(params))
+		values := make([]string, 0, len(params))
+		for _, p := range params {
+			lit, ok := n.parse(p)
+			if !ok {
+				return check{}, fmt.Errorf("invalid in parameter %q", r.Param)
+			}
+			conds = append(conds, fmt.Sprintf("%s == %s", value, lit))
+			values = append(values, lit)
+		}
+		return check{
+			"!(" + strings.Join(conds, " || ") + ")",
+			n.verb + " is not in %v",
+			[]string{value, "[]" + n.conv + "{" + strings.Join(values, ", ") + "}"},
+		}, nil
+	case "min", "gte":
+		op, format = "<", n.verb+" is less than min allowed "+n.verb
+	case "max", "lte":
+		op, format = ">", n.verb+" is higher than max allowed "+n.verb
+	default:
+		return check{}, fmt.Errorf("rule %q is not supported for %s", r.Name, n.kind)
+	}
+	lit, ok := n.parse(r.Param)
+	if !ok {
+		return check{}, fmt.Errorf("invalid %s parameter %q", r.Name, r.Param)
+	}
+	arg := lit
+	if n.conv != "int64" {
+		arg = n.conv + "(" + lit + ")"
+	}
+	return check{fmt.Sprintf("%s %s %s", value, op, lit), format, []string{value, arg}}, nil
+}
+
+func parseBoolParam(s string) (bool, bool) {
+	switch s {
+	case "true":
+		return true, true
+	case "false":
+		return false, true
+	}
+	return false, false
+}
+
+func boolCheck(expr string, r validator.Rule) (check, error) {
+	value := "bool(" + expr + ")"
+	switch r.Name {
+	case "required":
+		if len(r.Param) != 0 {
+			return check{}, fmt.Errorf("invalid rule %q", r.Name)
+		}
+		return check{"!" + value, "is required", nil}, nil
+	case "eq":
+		b, ok := parseBoolParam(r.Param)
+		if !ok {
+			return check{}, fmt.Errorf("invalid eq parameter %q", r.Param)
+		}
+		return check{fmt.Sprintf("%s != %t", value, b), "%t is not equal to %t", []string{value, strconv.FormatBool(b)}}, nil
+	case "in":
+		params := strings.Split(r.Param, ",")
+		conds := make([]string, 0, len(params))
+		values := make([]string, 0, len(params))
+		for _, p := range params {
+			b, ok := parseBoolParam(p)
+			if !ok {
+				return check{}, fmt.Errorf("invalid in parameter %q", r.Param)
+			}
+			conds = append(conds, fmt.Sprintf("%s == %t", value, b))
+			values = append(values, strconv.FormatBool(b))
+		}
+		return check{
+			"!(" + strings.Join(conds, " || ") + ")",
+			"%t is not in %v",
+			[]string{value, "[]bool{" + strings.Join(values, ", ") + "}"},
+		}, nil
+	}
+	return check{}, fmt.Errorf("rule %q is not supported for booleans", r.Name)
+}
+
+const joinFieldFunc = `
+func joinValidationField(parent, field string) string {
+	if len(field) == 0 {
+		return parent
+	}
+	if strings.HasPrefix(field, "[") {
+		return parent + field
+	}
+	return parent + "." + field
+}
+`
+
+func (g *generator) file(body []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by validatorgen. DO NOT EDIT.\n\npackage %s\n\nimport (\n", g.pkg)
+	imports := make([]string, 0, len(g.imports))
+	for imp := range g.imports {
+		imports = append(imports, imp)
+	}
+	sort.Slice(imports, func(i, j int) bool {
+		if stdI, stdJ := !strings.Contains(imports[i], "."), !strings.Contains(imports[j], "."); stdI != stdJ {
+			return stdI
+		}
+		return imports[i] < imports[j]
+	})
+	for i, imp := range imports {
+		if i > 0 && strings.Contains(imp, ".") && !strings.Contains(imports[i-1], ".") {
+			buf.WriteString("\n")
+		}
+		fmt.Fprintf(&buf, "%q\n", imp)
+	}
+	buf.WriteString(")\n\n")
+	buf.Write(body)
+	if g.join {
+		buf.WriteString(joinFieldFunc)
+	}
+	return format.Source(buf.Bytes())
+}
@@ -0,0 +1,175 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateDeterministic(t *testing.T) {
+	dir := filepath.Join("internal", "fixture")
+	want, err := os.ReadFile(filepath.Join(dir, "fixture_validator.go"))
+	require.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		got, err := generate(dir, nil, "fixture_validator.go")
+		require.NoError(t, err)
+		assert.Equal(t, string(want), string(got))
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     string
+		types   []string
+		wantErr string
+	}{
+		{
+			name: "selected type",
+			src: `package p
+type A struct {
+	X string ` + "`validate:\"required\"`" + `
+}
+type B struct {
+	Y int ` + "`validate:\"min:1\"`" + `
+}`,
+			types: []string{"B"},
+		},
+		{
+			name: "unsupported rule",
+			src: `package p
+type A struct {
+	X string ` + "`validate:\"email\"`" + `
+}`,
+			wantErr: `A.X: rule "email" is not supported for strings`,
+		},
+		{
+			name: "unsupported float rule",
+			src: `package p
+type A struct {
+	X float64 ` + "`validate:\"gt:1\"`" + `
+}`,
+			wantErr: `A.X: rule "gt" is not supported for floats`,
+		},
+		{
+			name: "unsupported cross-field rule",
+			src: `package p
+type A struct {
+	X string ` + "`validate:\"eqfield:Y\"`" + `
+	Y string
+}`,
+			wantErr: `A.X: rule "eqfield" is not supported for strings`,
+		},
+		{
+			name: "invalid bool param",
+			src: `package p
+type A struct {
+	X bool ` + "`validate:\"eq:yes\"`" + `
+}`,
+			wantErr: `A.X: invalid eq parameter "yes"`,
+		},
+		{
+			name: "map",
+			src: `package p
+type A struct {
+	X map[string]int ` + "`validate:\"maxitems:1\"`" + `
+}`,
+			wantErr: "A.X: field type is not supported",
+		},
+		{
+			name: "array",
+			src: `package p
+type A struct {
+	X [2]int ` + "`validate:\"min:1\"`" + `
+}`,
+			wantErr: "A.X: field type is not supported",
+		},
+		{
+			name: "time",
+			src: `package p
+import "time"
+type A struct {
+	X time.Time ` + "`validate:\"required\"`" + `
+}`,
+			wantErr: "A.X: field type is not supported",
+		},
+		{
+			name: "invalid param",
+			src: `package p
+type A struct {
+	X int ` + "`validate:\"max:ten\"`" + `
+}`,
+			wantErr: `A.X: invalid max parameter "ten"`,
+		},
+		{
+			name: "dive on non-slice",
+			src: `package p
+type A struct {
+	X string ` + "`validate:\"dive;required\"`" + `
+}`,
+			wantErr: "A.X: dive requires a slice field",
+		},
+		{
+			name: "nested dive",
+			src: `package p
+type A struct {
+	X [][]string ` + "`validate:\"dive;dive;required\"`" + `
+}`,
+			wantErr: "A.X: nested dive is not supported",
+		},
+		{
+			name: "rule before dive",
+			src: `package p
+type A struct {
+	X []string ` + "`validate:\"email;dive;required\"`" + `
+}`,
+			wantErr: `A.X: rule "email" is not supported before dive`,
+		},
+		{
+			name: "dive into structs",
+			src: `package p
+type B struct {
+	Y int ` + "`validate:\"min:1\"`" + `
+}
+type A struct {
+	X []B ` + "`validate:\"dive;required\"`" + `
+}`,
+			types:   []string{"A"},
+			wantErr: "A.X: dive is not supported on slices of structs",
+		},
+		{
+			name: "unexported field",
+			src: `package p
+type A struct {
+	x string ` + "`validate:\"required\"`" + `
+}`,
+			wantErr: "A.x: unexported fields cannot be validated",
+		},
+		{
+			name: "unknown type",
+			src: `package p
+type A struct{}`,
+			types:   []string{"C"},
+			wantErr: "C is not a struct type in package p",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			require.NoError(t, os.WriteFile(filepath.Join(dir, "p.go"), []byte(tt.src), 0o644))
+
+			src, err := generate(dir, tt.types, "validator_gen.go")
+			if len(tt.wantErr) != 0 {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Contains(t, string(src), "func ValidateB(v B) error")
+			assert.NotContains(t, string(src), "func ValidateA(")
+		})
+	}
+}
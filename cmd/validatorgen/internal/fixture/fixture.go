@@ -0,0 +1,73 @@
+package fixture
+
+//go:generate go run ../.. -output fixture_validator.go
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/ArtyomViryutin/validator"
+)
+
+var (
+	errLegacySource = errors.New("legacy source")
+	errContactValue = errors.New("email contact must contain @")
+)
+
+type Role string
+
+type Level int8
+
+type User struct {
+	Meta
+	Name     string   `validate:"required;min:2;max:16"`
+	Email    string   `validate:"omitempty;max:32"`
+	Role     Role     `validate:"in:admin,user,guest"`
+	Code     string   `validate:"len:4"`
+	Age      int      `validate:"min:18;max:130"`
+	Level    Level    `validate:"in:1,2,3"`
+	Score    int64    `validate:"gte:0;lte:100"`
+	Count    uint16   `validate:"max:100"`
+	Ratio    float64  `validate:"min:0.5;max:2.5"`
+	Weight   float32  `validate:"omitempty;in:1.5,2.5"`
+	Active   bool     `validate:"eq:true"`
+	Nickname *string  `validate:"required;min:3"`
+	Rank     *int     `validate:"omitempty;max:10"`
+	Tags     []string `validate:"maxitems:3;max:5"`
+	Numbers  []int    `validate:"omitempty;minitems:1;min:1"`
+	Grades   []uint   `validate:"max:3;dive;min:1;max:5"`
+	Labels   []string `validate:"dive;omitempty;max:4"`
+	Address  Address
+	Billing  *Address
+	Contacts []Contact `validate:"required;maxitems:2"`
+	note     string
+}
+
+type Meta struct {
+	Source string `validate:"required"`
+}
+
+func (m *Meta) ValidateStruct() error {
+	if m.Source == "legacy" {
+		return validator.ValidationError{Field: "Source", Rule: "legacy", Err: errLegacySource}
+	}
+	return nil
+}
+
+type Address struct {
+	City string `validate:"required"`
+	Zip  string `validate:"len:5"`
+}
+
+type Contact struct {
+	Kind  string `validate:"in:phone,email"`
+	Value string `validate:"required;max:20"`
+}
+
+func (c Contact) ValidateStruct() error {
+	if c.Kind == "email" && !strings.Contains(c.Value, "@") {
+		return errContactValue
+	}
+	return nil
+}
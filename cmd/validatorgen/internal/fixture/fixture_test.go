@@ -0,0 +1,180 @@
+package fixture
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ArtyomViryutin/validator"
+)
+
+func validUser() User {
+	nickname := "alice"
+	return User{
+		Meta:     Meta{Source: "web"},
+		Name:     "Alice",
+		Role:     "admin",
+		Code:     "AB12",
+		Age:      30,
+		Level:    2,
+		Score:    50,
+		Ratio:    1,
+		Active:   true,
+		Nickname: &nickname,
+		Tags:     []string{"go"},
+		Address:  Address{City: "Moscow", Zip: "10100"},
+		Contacts: []Contact{{Kind: "phone", Value: "+7000"}},
+	}
+}
+
+type flatError struct {
+	Field, Rule, Param, Value, Err string
+}
+
+func flatten(t *testing.T, err error) []flatError {
+	var errs validator.ValidationErrors
+	require.ErrorAs(t, err, &errs)
+	flat := make([]flatError, 0, len(errs))
+	for _, e := range errs {
+		flat = append(flat, flatError{e.Field, e.Rule, e.Param, e.Value, e.Err.Error()})
+	}
+	return flat
+}
+
+func TestGeneratedMatchesReflective(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(u *User)
+	}{
+		{
+			name:   "valid",
+			modify: func(u *User) {},
+		},
+		{
+			name: "strings",
+			modify: func(u *User) {
+				u.Name = ""
+				u.Email = strings.Repeat("e", 33)
+				u.Role = "root"
+				u.Code = "A"
+			},
+		},
+		{
+			name: "integers",
+			modify: func(u *User) {
+				u.Age = 200
+				u.Level = 7
+				u.Score = -1
+			},
+		},
+		{
+			name: "numbers",
+			modify: func(u *User) {
+				u.Count = 101
+				u.Ratio = 0.25
+				u.Weight = 2
+			},
+		},
+		{
+			name: "float limits",
+			modify: func(u *User) {
+				u.Ratio = 3
+				u.Weight = 1.5
+			},
+		},
+		{
+			name: "bool",
+			modify: func(u *User) {
+				u.Active = false
+			},
+		},
+		{
+			name: "nil pointers",
+			modify: func(u *User) {
+				u.Nickname = nil
+				u.Rank = nil
+			},
+		},
+		{
+			name: "pointers",
+			modify: func(u *User) {
+				nickname, rank := "al", 11
+				u.Nickname = &nickname
+				u.Rank = &rank
+			},
+		},
+		{
+			name: "dive",
+			modify: func(u *User) {
+				u.Grades = []uint{0, 3, 6, 5}
+				u.Labels = []string{"", "ok", "toolong"}
+			},
+		},
+		{
+			name: "embedded",
+			modify: func(u *User) {
+				u.Source = ""
+			},
+		},
+		{
+			name: "hooks",
+			modify: func(u *User) {
+				u.Source = "legacy"
+				u.Contacts = []Contact{{Kind: "phone", Value: "+7000"}, {Kind: "email", Value: "nobody"}}
+			},
+		},
+		{
+			name: "slices",
+			modify: func(u *User) {
+				u.Tags = []string{"a", "toolong", "b", "c"}
+				u.Numbers = []int{}
+				u.Contacts = nil
+			},
+		},
+		{
+			name: "slice elements",
+			modify: func(u *User) {
+				u.Numbers = []int{1, 0, -5}
+				u.Contacts = []Contact{{Kind: "fax"}, {Kind: "email", Value: "a@b.c"}, {}}
+			},
+		},
+		{
+			name: "nested",
+			modify: func(u *User) {
+				u.Address = Address{Zip: "1"}
+				u.Billing = &Address{City: "Kazan"}
+			},
+		},
+		{
+			name: "unexported ignored",
+			modify: func(u *User) {
+				u.note = "x"
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := validUser()
+			tt.modify(&u)
+
+			want := validator.Validate(u)
+			got := ValidateUser(u)
+			if want == nil {
+				assert.NoError(t, got)
+				return
+			}
+			require.Error(t, got)
+			assert.Equal(t, flatten(t, want), flatten(t, got))
+			assert.Equal(t, want.Error(), got.Error())
+
+			wantJSON, err := json.Marshal(want)
+			require.NoError(t, err)
+			gotJSON, err := json.Marshal(got)
+			require.NoError(t, err)
+			assert.Equal(t, string(wantJSON), string(gotJSON))
+		})
+	}
+}
@@ -0,0 +1,254 @@
+// Code generated by validatorgen. DO NOT EDIT.
+
+package fixture
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/ArtyomViryutin/validator"
+	"github.com/pkg/errors"
+)
+
+func ValidateAddress(v Address) error {
+	if errs := validateAddress(v); len(errs) != 0 {
+		return errs
+	}
+	return nil
+}
+
+func validateAddress(v Address) validator.ValidationErrors {
+	var errs validator.ValidationErrors
+	if v.City == "" {
+		errs = append(errs, validator.ValidationError{Field: "City", Rule: "required", Value: fmt.Sprint(v.City), Err: errors.New("is required")})
+	}
+	if len(v.Zip) != 5 {
+		errs = append(errs, validator.ValidationError{Field: "Zip", Rule: "len", Param: "5", Value: fmt.Sprint(v.Zip), Err: fmt.Errorf("len of %s is not equal to %d", string(v.Zip), 5)})
+	}
+	return errs
+}
+
+func ValidateContact(v Contact) error {
+	if errs := validateContact(v); len(errs) != 0 {
+		return errs
+	}
+	return nil
+}
+
+func validateContact(v Contact) validator.ValidationErrors {
+	errs := validateContactFields(v)
+	switch err := (&v).ValidateStruct().(type) {
+	case nil:
+	case validator.ValidationErrors:
+		errs = append(errs, err...)
+	case validator.ValidationError:
+		errs = append(errs, err)
+	default:
+		errs = append(errs, validator.ValidationError{Err: err})
+	}
+	return errs
+}
+
+func validateContactFields(v Contact) validator.ValidationErrors {
+	var errs validator.ValidationErrors
+	if !(string(v.Kind) == "phone" || string(v.Kind) == "email") {
+		errs = append(errs, validator.ValidationError{Field: "Kind", Rule: "in", Param: "phone,email", Value: fmt.Sprint(v.Kind), Err: fmt.Errorf("%s is not in %v", string(v.Kind), []string{"phone", "email"})})
+	}
+	if v.Value == "" {
+		errs = append(errs, validator.ValidationError{Field: "Value", Rule: "required", Value: fmt.Sprint(v.Value), Err: errors.New("is required")})
+	}
+	if len(v.Value) > 20 {
+		errs = append(errs, validator.ValidationError{Field: "Value", Rule: "max", Param: "20", Value: fmt.Sprint(v.Value), Err: fmt.Errorf("len of %s is higher than max allowed %d", string(v.Value), 20)})
+	}
+	return errs
+}
+
+func ValidateMeta(v Meta) error {
+	if errs := validateMeta(v); len(errs) != 0 {
+		return errs
+	}
+	return nil
+}
+
+func validateMeta(v Meta) validator.ValidationErrors {
+	errs := validateMetaFields(v)
+	switch err := (&v).ValidateStruct().(type) {
+	case nil:
+	case validator.ValidationErrors:
+		errs = append(errs, err...)
+	case validator.ValidationError:
+		errs = append(errs, err)
+	default:
+		errs = append(errs, validator.ValidationError{Err: err})
+	}
+	return errs
+}
+
+func validateMetaFields(v Meta) validator.ValidationErrors {
+	var errs validator.ValidationErrors
+	if v.Source == "" {
+		errs = append(errs, validator.ValidationError{Field: "Source", Rule: "required", Value: fmt.Sprint(v.Source), Err: errors.New("is required")})
+	}
+	return errs
+}
+
+func ValidateUser(v User) error {
+	if errs := validateUser(v); len(errs) != 0 {
+		return errs
+	}
+	return nil
+}
+
+func validateUser(v User) validator.ValidationErrors {
+	errs := validateUserFields(v)
+	switch err := (&v).ValidateStruct().(type) {
+	case nil:
+	case validator.ValidationErrors:
+		errs = append(errs, err...)
+	case validator.ValidationError:
+		errs = append(errs, err)
+	default:
+		errs = append(errs, validator.ValidationError{Err: err})
+	}
+	return errs
+}
+
+func validateUserFields(v User) validator.ValidationErrors {
+	var errs validator.ValidationErrors
+	errs = append(errs, validateMetaFields(v.Meta)...)
+	if v.Name == "" {
+		errs = append(errs, validator.ValidationError{Field: "Name", Rule: "required", Value: fmt.Sprint(v.Name), Err: errors.New("is required")})
+	}
+	if len(v.Name) < 2 {
+		errs = append(errs, validator.ValidationError{Field: "Name", Rule: "min", Param: "2", Value: fmt.Sprint(v.Name), Err: fmt.Errorf("len of %s is less than min allowed %d", string(v.Name), 2)})
+	}
+	if len(v.Name) > 16 {
+		errs = append(errs, validator.ValidationError{Field: "Name", Rule: "max", Param: "16", Value: fmt.Sprint(v.Name), Err: fmt.Errorf("len of %s is higher than max allowed %d", string(v.Name), 16)})
+	}
+	if v.Email != "" {
+		if len(v.Email) > 32 {
+			errs = append(errs, validator.ValidationError{Field: "Email", Rule: "max", Param: "32", Value: fmt.Sprint(v.Email), Err: fmt.Errorf("len of %s is higher than max allowed %d", string(v.Email), 32)})
+		}
+	}
+	if !(string(v.Role) == "admin" || string(v.Role) == "user" || string(v.Role) == "guest") {
+		errs = append(errs, validator.ValidationError{Field: "Role", Rule: "in", Param: "admin,user,guest", Value: fmt.Sprint(v.Role), Err: fmt.Errorf("%s is not in %v", string(v.Role), []string{"admin", "user", "guest"})})
+	}
+	if len(v.Code) != 4 {
+		errs = append(errs, validator.ValidationError{Field: "Code", Rule: "len", Param: "4", Value: fmt.Sprint(v.Code), Err: fmt.Errorf("len of %s is not equal to %d", string(v.Code), 4)})
+	}
+	if int64(v.Age) < 18 {
+		errs = append(errs, validator.ValidationError{Field: "Age", Rule: "min", Param: "18", Value: fmt.Sprint(v.Age), Err: fmt.Errorf("%d is less than min allowed %d", int64(v.Age), 18)})
+	}
+	if int64(v.Age) > 130 {
+		errs = append(errs, validator.ValidationError{Field: "Age", Rule: "max", Param: "130", Value: fmt.Sprint(v.Age), Err: fmt.Errorf("%d is higher than max allowed %d", int64(v.Age), 130)})
+	}
+	if !(int64(v.Level) == 1 || int64(v.Level) == 2 || int64(v.Level) == 3) {
+		errs = append(errs, validator.ValidationError{Field: "Level", Rule: "in", Param: "1,2,3", Value: fmt.Sprint(v.Level), Err: fmt.Errorf("%d is not in %v", int64(v.Level), []int64{1, 2, 3})})
+	}
+	if int64(v.Score) < 0 {
+		errs = append(errs, validator.ValidationError{Field: "Score", Rule: "gte", Param: "0", Value: fmt.Sprint(v.Score), Err: fmt.Errorf("%d is less than min allowed %d", int64(v.Score), 0)})
+	}
+	if int64(v.Score) > 100 {
+		errs = append(errs, validator.ValidationError{Field: "Score", Rule: "lte", Param: "100", Value: fmt.Sprint(v.Score), Err: fmt.Errorf("%d is higher than max allowed %d", int64(v.Score), 100)})
+	}
+	if uint64(v.Count) > 100 {
+		errs = append(errs, validator.ValidationError{Field: "Count", Rule: "max", Param: "100", Value: fmt.Sprint(v.Count), Err: fmt.Errorf("%d is higher than max allowed %d", uint64(v.Count), uint64(100))})
+	}
+	if float64(v.Ratio) < 0.5 {
+		errs = append(errs, validator.ValidationError{Field: "Ratio", Rule: "min", Param: "0.5", Value: fmt.Sprint(v.Ratio), Err: fmt.Errorf("%g is less than min allowed %g", float64(v.Ratio), float64(0.5))})
+	}
+	if float64(v.Ratio) > 2.5 {
+		errs = append(errs, validator.ValidationError{Field: "Ratio", Rule: "max", Param: "2.5", Value: fmt.Sprint(v.Ratio), Err: fmt.Errorf("%g is higher than max allowed %g", float64(v.Ratio), float64(2.5))})
+	}
+	if math.Float64bits(float64(v.Weight)) != 0 {
+		if !(float64(v.Weight) == 1.5 || float64(v.Weight) == 2.5) {
+			errs = append(errs, validator.ValidationError{Field: "Weight", Rule: "in", Param: "1.5,2.5", Value: fmt.Sprint(v.Weight), Err: fmt.Errorf("%g is not in %v", float64(v.Weight), []float64{1.5, 2.5})})
+		}
+	}
+	if bool(v.Active) != true {
+		errs = append(errs, validator.ValidationError{Field: "Active", Rule: "eq", Param: "true", Value: fmt.Sprint(v.Active), Err: fmt.Errorf("%t is not equal to %t", bool(v.Active), true)})
+	}
+	if v.Nickname == nil {
+		errs = append(errs, validator.ValidationError{Field: "Nickname", Rule: "required", Err: errors.New("is required")})
+	}
+	if v.Nickname != nil {
+		if len(*v.Nickname) < 3 {
+			errs = append(errs, validator.ValidationError{Field: "Nickname", Rule: "min", Param: "3", Value: fmt.Sprint(*v.Nickname), Err: fmt.Errorf("len of %s is less than min allowed %d", string(*v.Nickname), 3)})
+		}
+	}
+	if v.Rank != nil {
+		if int64(*v.Rank) > 10 {
+			errs = append(errs, validator.ValidationError{Field: "Rank", Rule: "max", Param: "10", Value: fmt.Sprint(*v.Rank), Err: fmt.Errorf("%d is higher than max allowed %d", int64(*v.Rank), 10)})
+		}
+	}
+	if len(v.Tags) > 3 {
+		errs = append(errs, validator.ValidationError{Field: "Tags", Rule: "maxitems", Param: "3", Value: fmt.Sprint(v.Tags), Err: fmt.Errorf("number of items %d is higher than max allowed %d", len(v.Tags), 3)})
+	}
+	for i, elem := range v.Tags {
+		if len(elem) > 5 {
+			errs = append(errs, validator.ValidationError{Field: fmt.Sprintf("Tags[%d]", i), Rule: "max", Param: "5", Value: fmt.Sprint(elem), Err: fmt.Errorf("len of %s is higher than max allowed %d", string(elem), 5)})
+		}
+	}
+	if v.Numbers != nil {
+		if len(v.Numbers) < 1 {
+			errs = append(errs, validator.ValidationError{Field: "Numbers", Rule: "minitems", Param: "1", Value: fmt.Sprint(v.Numbers), Err: fmt.Errorf("number of items %d is less than min allowed %d", len(v.Numbers), 1)})
+		}
+		for i, elem := range v.Numbers {
+			if int64(elem) < 1 {
+				errs = append(errs, validator.ValidationError{Field: fmt.Sprintf("Numbers[%d]", i), Rule: "min", Param: "1", Value: fmt.Sprint(elem), Err: fmt.Errorf("%d is less than min allowed %d", int64(elem), 1)})
+			}
+		}
+	}
+	if len(v.Grades) > 3 {
+		errs = append(errs, validator.ValidationError{Field: "Grades", Rule: "max", Param: "3", Value: fmt.Sprint(v.Grades), Err: fmt.Errorf("number of items %d is higher than max allowed %d", len(v.Grades), 3)})
+	}
+	for i, elem := range v.Grades {
+		if uint64(elem) < 1 {
+			errs = append(errs, validator.ValidationError{Field: fmt.Sprintf("Grades[%d]", i), Rule: "min", Param: "1", Value: fmt.Sprint(elem), Err: fmt.Errorf("%d is less than min allowed %d", uint64(elem), uint64(1))})
+		}
+		if uint64(elem) > 5 {
+			errs = append(errs, validator.ValidationError{Field: fmt.Sprintf("Grades[%d]", i), Rule: "max", Param: "5", Value: fmt.Sprint(elem), Err: fmt.Errorf("%d is higher than max allowed %d", uint64(elem), uint64(5))})
+		}
+	}
+	for i, elem := range v.Labels {
+		if elem != "" {
+			if len(elem) > 4 {
+				errs = append(errs, validator.ValidationError{Field: fmt.Sprintf("Labels[%d]", i), Rule: "max", Param: "4", Value: fmt.Sprint(elem), Err: fmt.Errorf("len of %s is higher than max allowed %d", string(elem), 4)})
+			}
+		}
+	}
+	for _, err := range validateAddress(v.Address) {
+		err.Field = "Address." + err.Field
+		errs = append(errs, err)
+	}
+	if v.Billing != nil {
+		for _, err := range validateAddress(*v.Billing) {
+			err.Field = "Billing." + err.Field
+			errs = append(errs, err)
+		}
+	}
+	if v.Contacts == nil {
+		errs = append(errs, validator.ValidationError{Field: "Contacts", Rule: "required", Value: fmt.Sprint(v.Contacts), Err: errors.New("is required")})
+	}
+	if len(v.Contacts) > 2 {
+		errs = append(errs, validator.ValidationError{Field: "Contacts", Rule: "maxitems", Param: "2", Value: fmt.Sprint(v.Contacts), Err: fmt.Errorf("number of items %d is higher than max allowed %d", len(v.Contacts), 2)})
+	}
+	for i, elem := range v.Contacts {
+		for _, err := range validateContact(elem) {
+			err.Field = joinValidationField(fmt.Sprintf("Contacts[%d]", i), err.Field)
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+func joinValidationField(parent, field string) string {
+	if len(field) == 0 {
+		return parent
+	}
+	if strings.HasPrefix(field, "[") {
+		return parent + field
+	}
+	return parent + "." + field
+}
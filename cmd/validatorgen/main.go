@@ -0,0 +1,46 @@
+// Validatorgen generates reflection-free Validate<Type> functions that report the
+// same ValidationErrors as validator.Validate.
+//
+// Supported fields are strings, integers, unsigned integers, floats, bools,
+// structs, pointers to them and slices of them. Strings support required, len,
+// min, max and in; numbers support required, min, max, gte, lte and in; bools
+// support required, eq and in; slices support required, lenitems, minitems,
+// maxitems and dive with element rules. Embedded structs and ValidateStruct
+// hooks are handled like the reflective validator does.
+//
+// Maps, arrays, time and other library types, cross-field rules, custom rules,
+// RegisterStructValidation and cyclic values are not supported; the command
+// fails with an error naming the field and the rule or type it cannot handle.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func main() {
+	output := flag.String("output", "validator_gen.go", "output file name, relative to the package directory")
+	types := flag.String("type", "", "comma-separated list of struct types; defaults to every struct with validate tags")
+	flag.Parse()
+
+	dir := "."
+	if flag.NArg() > 0 {
+		dir = flag.Arg(0)
+	}
+	var names []string
+	if len(*types) != 0 {
+		names = strings.Split(*types, ",")
+	}
+	src, err := generate(dir, names, *output)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "validatorgen:", err)
+		os.Exit(1)
+	}
+	if err := os.WriteFile(filepath.Join(dir, *output), src, 0o644); err != nil {
+		fmt.Fprintln(os.Stderr, "validatorgen:", err)
+		os.Exit(1)
+	}
+}
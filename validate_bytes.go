@@ -0,0 +1,64 @@
+package validator
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+type bytesLenValidator struct {
+	l int
+}
+
+func (v bytesLenValidator) validate(b reflect.Value) error {
+	if b.Len() != v.l {
+		return fmt.Errorf("len of %d bytes is not equal to %d", b.Len(), v.l)
+	}
+	return nil
+}
+
+func newBytesLenValidator(s string) (fieldValidator, error) {
+	val, err := strconv.Atoi(s)
+	if err != nil {
+		return nil, err
+	}
+	return bytesLenValidator{val}, nil
+}
+
+type bytesMinValidator struct {
+	min int
+}
+
+func (v bytesMinValidator) validate(b reflect.Value) error {
+	if b.Len() < v.min {
+		return fmt.Errorf("len of %d bytes is less than min allowed %d", b.Len(), v.min)
+	}
+	return nil
+}
+
+func newBytesMinValidator(s string) (fieldValidator, error) {
+	val, err := strconv.Atoi(s)
+	if err != nil {
+		return nil, err
+	}
+	return bytesMinValidator{val}, nil
+}
+
+type bytesMaxValidator struct {
+	max int
+}
+
+func (v bytesMaxValidator) validate(b reflect.Value) error {
+	if b.Len() > v.max {
+		return fmt.Errorf("len of %d bytes is higher than max allowed %d", b.Len(), v.max)
+	}
+	return nil
+}
+
+func newBytesMaxValidator(s string) (fieldValidator, error) {
+	val, err := strconv.Atoi(s)
+	if err != nil {
+		return nil, err
+	}
+	return bytesMaxValidator{val}, nil
+}
@@ -0,0 +1,87 @@
+package validator
+
+import (
+	"context"
+	"reflect"
+)
+
+type FieldValidator interface {
+	Rule() Rule
+	Validate(v reflect.Value) error
+}
+
+type compiledRule struct {
+	rule      Rule
+	kind      reflect.Kind
+	omitEmpty bool
+	validator fieldValidator
+	val       *Validator
+}
+
+func (c compiledRule) Rule() Rule {
+	return c.rule
+}
+
+func (c compiledRule) Validate(v reflect.Value) error {
+	if !v.IsValid() || v.Kind() != c.kind {
+		return ErrUnsupportedType
+	}
+	if c.omitEmpty && v.IsZero() {
+		return nil
+	}
+	return validateWithCtx(c.val.statContext(context.Background()), c.validator, v)
+}
+
+var kindTypes = map[reflect.Kind]reflect.Type{
+	reflect.Bool:    reflect.TypeOf(false),
+	reflect.Int:     reflect.TypeOf(int(0)),
+	reflect.Int8:    reflect.TypeOf(int8(0)),
+	reflect.Int16:   reflect.TypeOf(int16(0)),
+	reflect.Int32:   reflect.TypeOf(int32(0)),
+	reflect.Int64:   reflect.TypeOf(int64(0)),
+	reflect.Uint:    reflect.TypeOf(uint(0)),
+	reflect.Uint8:   reflect.TypeOf(uint8(0)),
+	reflect.Uint16:  reflect.TypeOf(uint16(0)),
+	reflect.Uint32:  reflect.TypeOf(uint32(0)),
+	reflect.Uint64:  reflect.TypeOf(uint64(0)),
+	reflect.Uintptr: reflect.TypeOf(uintptr(0)),
+	reflect.Float32: reflect.TypeOf(float32(0)),
+	reflect.Float64: reflect.TypeOf(float64(0)),
+	reflect.String:  reflect.TypeOf(""),
+	reflect.Slice:   reflect.TypeOf([]any(nil)),
+	reflect.Array:   reflect.TypeOf([0]any{}),
+	reflect.Map:     reflect.TypeOf(map[any]any(nil)),
+}
+
+func CompileRules(kind reflect.Kind, rules []Rule) ([]FieldValidator, error) {
+	return defaultValidator.CompileRules(kind, rules)
+}
+
+func (val *Validator) CompileRules(kind reflect.Kind, rules []Rule) ([]FieldValidator, error) {
+	t, ok := kindTypes[kind]
+	if !ok {
+		return nil, ErrUnsupportedType
+	}
+	validators, err := val.parseRules(nil, 0, t, rules)
+	if err != nil {
+		return nil, err
+	}
+	omitEmpty := false
+	if len(validators) != 0 {
+		_, omitEmpty = validators[0].(omitEmptyValidator)
+	}
+	if omitEmpty {
+		validators = validators[1:]
+	}
+	compiled := make([]FieldValidator, 0, len(validators))
+	for _, rule := range rules {
+		if rule.Name == "omitempty" {
+			continue
+		}
+		compiled = append(compiled, compiledRule{rule, kind, omitEmpty, validators[len(compiled)], val})
+		if rule.Name == "dive" {
+			break
+		}
+	}
+	return compiled, nil
+}
@@ -0,0 +1,165 @@
+package validator
+
+import (
+	"io/fs"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRules(t *testing.T) {
+	tests := []struct {
+		name    string
+		tag     string
+		want    []Rule
+		wantErr error
+	}{
+		{
+			name: "rules",
+			tag:  `required;min:2;in:a\;b,c`,
+			want: []Rule{{"required", ""}, {"min", "2"}, {"in", "a;b,c"}},
+		},
+		{
+			name: "empty param rule",
+			tag:  "ne:",
+			want: []Rule{{"ne", ""}},
+		},
+		{
+			name:    "empty rule",
+			tag:     "required;;min:2",
+			wantErr: ErrInvalidValidatorSyntax,
+		},
+		{
+			name:    "missing param",
+			tag:     "min:",
+			wantErr: ErrInvalidValidatorSyntax,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rules, err := ParseRules(tt.tag)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, rules)
+		})
+	}
+}
+
+func TestCompileRules(t *testing.T) {
+	tests := []struct {
+		name      string
+		kind      reflect.Kind
+		tag       string
+		value     any
+		wantRules []string
+		wantErrs  []string
+		wantErr   error
+	}{
+		{
+			name:      "string",
+			kind:      reflect.String,
+			tag:       "required;min:3",
+			value:     "ab",
+			wantRules: []string{"required", "min:3"},
+			wantErrs:  []string{"len of ab is less than min allowed 3"},
+		},
+		{
+			name:      "omitempty",
+			kind:      reflect.String,
+			tag:       "omitempty;min:3",
+			value:     "",
+			wantRules: []string{"min:3"},
+		},
+		{
+			name:      "int",
+			kind:      reflect.Int,
+			tag:       "max:10",
+			value:     11,
+			wantRules: []string{"max:10"},
+			wantErrs:  []string{"11 is higher than max allowed 10"},
+		},
+		{
+			name:      "slice",
+			kind:      reflect.Slice,
+			tag:       "minitems:2;maxitems:5",
+			value:     []string{"a"},
+			wantRules: []string{"minitems:2", "maxitems:5"},
+			wantErrs:  []string{"number of items 1 is less than min allowed 2"},
+		},
+		{
+			name:    "dive needs element type",
+			kind:    reflect.Slice,
+			tag:     "dive;required",
+			wantErr: ErrRuleNotApplicable,
+		},
+		{
+			name:    "kind mismatch",
+			kind:    reflect.Int,
+			tag:     "len:3",
+			wantErr: ErrRuleNotApplicable,
+		},
+		{
+			name:    "unknown rule",
+			kind:    reflect.String,
+			tag:     "emial",
+			wantErr: ErrUnknownRule,
+		},
+		{
+			name:    "cross field",
+			kind:    reflect.String,
+			tag:     "eqfield:Password",
+			wantErr: ErrInvalidValidatorSyntax,
+		},
+		{
+			name:    "unsupported kind",
+			kind:    reflect.Struct,
+			tag:     "required",
+			wantErr: ErrUnsupportedType,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rules, err := ParseRules(tt.tag)
+			require.NoError(t, err)
+			validators, err := CompileRules(tt.kind, rules)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			var gotRules, gotErrs []string
+			for _, validator := range validators {
+				gotRules = append(gotRules, validator.Rule().String())
+				if err := validator.Validate(reflect.ValueOf(tt.value)); err != nil {
+					gotErrs = append(gotErrs, err.Error())
+				}
+			}
+			assert.Equal(t, tt.wantRules, gotRules)
+			assert.Equal(t, tt.wantErrs, gotErrs)
+		})
+	}
+
+	validators, err := CompileRules(reflect.String, []Rule{{"required", ""}})
+	require.NoError(t, err)
+	assert.ErrorIs(t, validators[0].Validate(reflect.ValueOf(1)), ErrUnsupportedType)
+}
+
+func TestCompileRulesStatFunc(t *testing.T) {
+	v := New(WithStatFunc(func(string) (fs.FileInfo, error) {
+		return nil, os.ErrNotExist
+	}))
+	validators, err := v.CompileRules(reflect.String, []Rule{{Name: "file"}})
+	require.NoError(t, err)
+	require.Len(t, validators, 1)
+
+	err = validators[0].Validate(reflect.ValueOf("/etc/passwd"))
+	assert.EqualError(t, err, v.ValidateVar("/etc/passwd", "file").Error())
+	assert.Contains(t, err.Error(), "does not exist")
+}
@@ -0,0 +1,124 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"github.com/pkg/errors"
+	"reflect"
+)
+
+var ErrBuiltinRule = errors.New("rule name is reserved by a built-in validator")
+
+type ValidationFunc func(v reflect.Value, param string) error
+
+type ValidationFuncCtx func(ctx context.Context, v reflect.Value, param string) error
+
+type customRule struct {
+	fn    ValidationFuncCtx
+	kinds []reflect.Kind
+}
+
+func WithBuiltinOverride() Option {
+	return func(val *Validator) {
+		val.overrideBuiltins = true
+	}
+}
+
+func RegisterValidation(name string, fn ValidationFunc, kinds ...reflect.Kind) error {
+	return defaultValidator.RegisterValidation(name, fn, kinds...)
+}
+
+func (val *Validator) RegisterValidation(name string, fn ValidationFunc, kinds ...reflect.Kind) error {
+	if fn == nil {
+		return val.RegisterValidationCtx(name, nil, kinds...)
+	}
+	return val.RegisterValidationCtx(name, func(_ context.Context, v reflect.Value, param string) error {
+		return fn(v, param)
+	}, kinds...)
+}
+
+func RegisterValidationCtx(name string, fn ValidationFuncCtx, kinds ...reflect.Kind) error {
+	return defaultValidator.RegisterValidationCtx(name, fn, kinds...)
+}
+
+func (val *Validator) RegisterValidationCtx(name string, fn ValidationFuncCtx, kinds ...reflect.Kind) error {
+	if len(name) == 0 || fn == nil || len(kinds) == 0 {
+		return fmt.Errorf("rule %q needs a name, a function and at least one kind", name)
+	}
+	if isBuiltinRule(name) && !val.overrideBuiltins {
+		return errors.Wrap(ErrBuiltinRule, name)
+	}
+	val.mu.Lock()
+	defer val.mu.Unlock()
+	if val.customRules == nil {
+		val.customRules = make(map[string]customRule)
+	}
+	val.customRules[name] = customRule{fn, kinds}
+	val.generation++
+	val.parsedTags.Range(func(key, _ any) bool {
+		val.parsedTags.Delete(key)
+		return true
+	})
+	return nil
+}
+
+func (val *Validator) customRule(name string) (customRule, bool) {
+	val.mu.RLock()
+	defer val.mu.RUnlock()
+	rule, ok := val.customRules[name]
+	return rule, ok
+}
+
+var builtinRuleMaps = []map[string]fieldValidatorCreator{
+	intValidators, uintValidators, floatValidators, boolValidators, strValidators, bytesValidators,
+	collectionValidators, timeValidators, durationValidators, nullValidators, ptrValidators,
+}
+
+func isBuiltinRule(name string) bool {
+	if _, ok := crossFieldValidators[name]; ok || name == "omitempty" || name == "dive" || name == "default" {
+		return true
+	}
+	for _, validators := range builtinRuleMaps {
+		if _, ok := validators[name]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+type customValidator struct {
+	fn    ValidationFuncCtx
+	param string
+}
+
+func (v customValidator) validate(f reflect.Value) error {
+	return v.validateCtx(context.Background(), f)
+}
+
+func (v customValidator) validateCtx(ctx context.Context, f reflect.Value) error {
+	return v.fn(ctx, f, v.param)
+}
+
+func createCustomValidator(rule customRule, t reflect.Type, name, param string) (fieldValidator, error) {
+	if contains(rule.kinds, t.Kind()) {
+		return ruleValidator{name, param, customValidator{rule.fn, param}}, nil
+	}
+	switch t.Kind() {
+	case reflect.Ptr:
+		validator, err := createCustomValidator(rule, t.Elem(), name, param)
+		if err != nil {
+			return nil, err
+		}
+		return ptrValidator{validator}, nil
+	case reflect.Slice, reflect.Array, reflect.Map:
+		validator, err := createCustomValidator(rule, t.Elem(), name, param)
+		if err != nil {
+			return nil, err
+		}
+		if t.Kind() == reflect.Map {
+			return mapValidator{validator}, nil
+		}
+		return sliceValidator{validator}, nil
+	}
+	return nil, ErrRuleNotApplicable
+}
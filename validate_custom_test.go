@@ -0,0 +1,324 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func even(v reflect.Value, _ string) error {
+	if v.Int()%2 != 0 {
+		return fmt.Errorf("%d is not even", v.Int())
+	}
+	return nil
+}
+
+func warehouseCode(v reflect.Value, param string) error {
+	if !strings.HasPrefix(v.String(), param+"-") {
+		return fmt.Errorf("%s has no %s- warehouse prefix", v.String(), param)
+	}
+	return nil
+}
+
+func TestRegisterValidation(t *testing.T) {
+	v := New()
+	assert.NoError(t, v.RegisterValidation("even", even, reflect.Int, reflect.Int64))
+	assert.NoError(t, v.RegisterValidation("warehouse_code", warehouseCode, reflect.String))
+
+	type item struct {
+		Code string `validate:"warehouse_code:EU;len:6"`
+	}
+	type order struct {
+		Quantity int     `validate:"min:2;even"`
+		Pallets  []int64 `validate:"even"`
+		Spare    *int    `validate:"even"`
+		Items    []item
+	}
+	assert.NoError(t, v.Validate(order{
+		Quantity: 4,
+		Pallets:  []int64{2, 4},
+		Spare:    intPtr(6),
+		Items:    []item{{"EU-001"}},
+	}))
+
+	err := v.Validate(order{
+		Quantity: 1,
+		Pallets:  []int64{2, 3},
+		Spare:    intPtr(5),
+		Items:    []item{{"EU-001"}, {"US-0001"}},
+	})
+	errs, ok := err.(ValidationErrors)
+	assert.True(t, ok)
+	assert.Len(t, errs, 6)
+	assert.Equal(t, "Quantity: 1 is less than min allowed 2", errs[0].Error())
+	assert.Equal(t, "Quantity: 1 is not even", errs[1].Error())
+	assert.Equal(t, "Pallets[1]: 3 is not even", errs[2].Error())
+	assert.Equal(t, "Spare: 5 is not even", errs[3].Error())
+	assert.Equal(t, "Items[1].Code: US-0001 has no EU- warehouse prefix", errs[4].Error())
+	assert.Equal(t, "Items[1].Code: len of US-0001 is not equal to 6", errs[5].Error())
+
+	t.Run("wrong kind is a syntax error", func(t *testing.T) {
+		err := v.Validate(struct {
+			Ratio float64 `validate:"even"`
+		}{})
+		errs := err.(ValidationErrors)
+		assert.ErrorIs(t, errs[0].Err, ErrInvalidValidatorSyntax)
+	})
+
+	t.Run("rules are scoped to the instance", func(t *testing.T) {
+		err := Validate(struct {
+			Quantity int `validate:"even"`
+		}{})
+		errs := err.(ValidationErrors)
+		assert.ErrorIs(t, errs[0].Err, ErrInvalidValidatorSyntax)
+	})
+
+	t.Run("registration invalidates cached syntax errors", func(t *testing.T) {
+		v := New()
+		type shift struct {
+			Hours int `validate:"odd"`
+		}
+		errs := v.Validate(shift{Hours: 2}).(ValidationErrors)
+		assert.ErrorIs(t, errs[0].Err, ErrInvalidValidatorSyntax)
+		assert.NoError(t, v.RegisterValidation("odd", func(v reflect.Value, _ string) error {
+			if v.Int()%2 == 0 {
+				return errors.New("is not odd")
+			}
+			return nil
+		}, reflect.Int))
+		assert.EqualError(t, v.Validate(shift{Hours: 2}), "is not odd")
+	})
+
+	t.Run("invalid registrations", func(t *testing.T) {
+		assert.Error(t, v.RegisterValidation("", even, reflect.Int))
+		assert.Error(t, v.RegisterValidation("even", nil, reflect.Int))
+		assert.Error(t, v.RegisterValidation("even", even))
+	})
+
+	t.Run("built-in names are protected", func(t *testing.T) {
+		for _, name := range []string{"min", "eqfield", "omitempty", "dive", "url"} {
+			assert.ErrorIs(t, v.RegisterValidation(name, even, reflect.Int), ErrBuiltinRule)
+		}
+	})
+
+	t.Run("built-in names can be overridden explicitly", func(t *testing.T) {
+		v := New(WithBuiltinOverride())
+		assert.NoError(t, v.RegisterValidation("min", even, reflect.Int))
+		assert.EqualError(t, v.Validate(struct {
+			N int `validate:"min:100"`
+		}{N: 3}), "3 is not even")
+	})
+
+	t.Run("concurrent registration and validation", func(t *testing.T) {
+		v := New()
+		var wg sync.WaitGroup
+		for i := 0; i < 8; i++ {
+			wg.Add(2)
+			go func(i int) {
+				defer wg.Done()
+				assert.NoError(t, v.RegisterValidation(fmt.Sprintf("rule%d", i), even, reflect.Int))
+			}(i)
+			go func() {
+				defer wg.Done()
+				_ = v.Validate(order{Quantity: 2})
+			}()
+		}
+		wg.Wait()
+	})
+}
+
+func TestWithFailFast(t *testing.T) {
+	var calls int
+	v := New(WithFailFast(true))
+	assert.NoError(t, v.RegisterValidation("counted", func(reflect.Value, string) error {
+		calls++
+		return nil
+	}, reflect.String, reflect.Int))
+
+	type inner struct {
+		Code string `validate:"len:3;counted"`
+		Size int    `validate:"counted"`
+	}
+	type outer struct {
+		Name   string `validate:"counted"`
+		Inner  inner
+		Items  []inner
+		Amount int `validate:"min:1;counted"`
+	}
+
+	err := v.Validate(outer{Inner: inner{Code: "toolong"}, Items: []inner{{Code: "x"}}})
+	errs, ok := err.(ValidationErrors)
+	assert.True(t, ok)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "Inner.Code: len of toolong is not equal to 3", errs[0].Error())
+	assert.Equal(t, 1, calls)
+
+	calls = 0
+	err = v.Validate(outer{Inner: inner{Code: "abc"}, Items: []inner{{Code: "abc"}, {Code: "x"}, {Code: "y"}}})
+	errs = err.(ValidationErrors)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "Items[1].Code: len of x is not equal to 3", errs[0].Error())
+	assert.Equal(t, 5, calls)
+
+	err = v.Validate(struct {
+		Tags []string `validate:"min:3"`
+	}{Tags: []string{"a", "b"}})
+	errs = err.(ValidationErrors)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "Tags[0]: len of a is less than min allowed 3", errs[0].Error())
+
+	type pair struct {
+		A string `validate:"min:1"`
+		B string `validate:"min:1"`
+	}
+	assert.Len(t, v.Validate(pair{}).(ValidationErrors), 1)
+	assert.Len(t, New(WithFailFast(false)).Validate(pair{}).(ValidationErrors), 2)
+
+	assert.NoError(t, v.RegisterValidation("rejected", func(reflect.Value, string) error {
+		calls++
+		return errors.New("rejected")
+	}, reflect.String))
+	tests := []struct {
+		name      string
+		v         any
+		wantField string
+	}{
+		{
+			name: "slice",
+			v: struct {
+				Tags []string `validate:"rejected"`
+			}{Tags: []string{"a", "b", "c"}},
+			wantField: "Tags[0]",
+		},
+		{
+			name: "map",
+			v: struct {
+				Labels map[string]string `validate:"rejected"`
+			}{Labels: map[string]string{"a": "1", "b": "2", "c": "3"}},
+			wantField: "Labels[a]",
+		},
+		{
+			name: "dive",
+			v: struct {
+				Tags []string `validate:"dive;rejected;len:5"`
+			}{Tags: []string{"a", "b", "c"}},
+			wantField: "Tags[0]",
+		},
+		{
+			name: "dive map",
+			v: struct {
+				Labels map[string]string `validate:"dive;rejected"`
+			}{Labels: map[string]string{"a": "1", "b": "2", "c": "3"}},
+			wantField: "Labels[a]",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls = 0
+			errs := v.Validate(tt.v).(ValidationErrors)
+			assert.Len(t, errs, 1)
+			assert.Equal(t, tt.wantField, errs[0].Field)
+			assert.Equal(t, 1, calls)
+		})
+	}
+}
+
+type flagsKey struct{}
+
+func enabledFlag(ctx context.Context, v reflect.Value, _ string) error {
+	flags, _ := ctx.Value(flagsKey{}).(map[string]bool)
+	if !flags[v.String()] {
+		return fmt.Errorf("flag %s is not enabled", v.String())
+	}
+	return nil
+}
+
+func TestValidateCtx(t *testing.T) {
+	v := New()
+	assert.NoError(t, v.RegisterValidationCtx("enabled_flag", enabledFlag, reflect.String))
+	assert.Error(t, v.RegisterValidationCtx("enabled_flag", nil, reflect.String))
+	assert.True(t, errors.Is(v.RegisterValidationCtx("min", enabledFlag, reflect.String), ErrBuiltinRule))
+
+	type request struct {
+		Primary  string            `validate:"min:1;enabled_flag"`
+		Extra    []string          `validate:"enabled_flag"`
+		Optional *string           `validate:"enabled_flag"`
+		Nested   map[string]string `validate:"dive;enabled_flag"`
+	}
+	beta := "beta"
+	req := request{
+		Primary:  "beta",
+		Extra:    []string{"beta", "gamma"},
+		Optional: &beta,
+		Nested:   map[string]string{"a": "alpha", "b": "beta"},
+	}
+	ctx := context.WithValue(context.Background(), flagsKey{}, map[string]bool{"alpha": true, "beta": true})
+
+	err := v.ValidateCtx(ctx, req)
+	errs, ok := err.(ValidationErrors)
+	assert.True(t, ok)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "Extra[1]: flag gamma is not enabled", errs[0].Error())
+
+	errs = v.Validate(req).(ValidationErrors)
+	assert.Len(t, errs, 6)
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	err = v.ValidateCtx(canceled, req)
+	assert.True(t, errors.Is(err, context.Canceled))
+	_, ok = err.(ValidationErrors)
+	assert.False(t, ok)
+
+	var calls int
+	midway, cancel := context.WithCancel(ctx)
+	defer cancel()
+	assert.NoError(t, v.RegisterValidationCtx("cancel_after", func(context.Context, reflect.Value, string) error {
+		calls++
+		cancel()
+		return nil
+	}, reflect.Int))
+	err = v.ValidateCtx(midway, struct {
+		A int `validate:"cancel_after"`
+		B int `validate:"cancel_after"`
+		C int `validate:"cancel_after"`
+	}{})
+	assert.True(t, errors.Is(err, context.Canceled))
+	assert.Equal(t, 1, calls)
+
+	assert.NoError(t, ValidateCtx(context.Background(), struct {
+		A int `validate:"min:0"`
+	}{}))
+}
+
+func TestRegisterValidationConcurrent(t *testing.T) {
+	type value struct {
+		N int `validate:"even"`
+	}
+	for i := 0; i < 200; i++ {
+		v := New()
+		var wg sync.WaitGroup
+		for j := 0; j < 4; j++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for k := 0; k < 20; k++ {
+					_ = v.Validate(value{N: 1})
+				}
+			}()
+		}
+		assert.NoError(t, v.RegisterValidation("even", even, reflect.Int))
+		wg.Wait()
+
+		errs, ok := v.Validate(value{N: 1}).(ValidationErrors)
+		assert.True(t, ok)
+		assert.Len(t, errs, 1)
+		assert.Equal(t, "N: 1 is not even", errs[0].Error())
+	}
+}
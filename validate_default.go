@@ -0,0 +1,172 @@
+package validator
+
+import (
+	"fmt"
+	"github.com/pkg/errors"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+var ErrNotPointer = errors.New("wrong argument given, should be a pointer to struct")
+
+type defaultValueValidator struct {
+	value reflect.Value
+}
+
+func (v defaultValueValidator) validate(reflect.Value) error {
+	return nil
+}
+
+func (v defaultValueValidator) fill(f reflect.Value) {
+	if !f.IsZero() || !f.CanSet() {
+		return
+	}
+	if f.Kind() == reflect.Ptr {
+		p := reflect.New(f.Type().Elem())
+		p.Elem().Set(v.value)
+		f.Set(p)
+		return
+	}
+	f.Set(v.value)
+}
+
+func newDefaultValueValidator(t reflect.Type, s string) (fieldValidator, error) {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	value := reflect.New(t).Elem()
+	switch t.Kind() {
+	case reflect.String:
+		value.SetString(s)
+	case reflect.Bool:
+		b, err := parseBool(s)
+		if err != nil {
+			return nil, err
+		}
+		value.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if t == durationType {
+			d, err := time.ParseDuration(s)
+			if err != nil {
+				return nil, err
+			}
+			value.SetInt(int64(d))
+			break
+		}
+		n, err := strconv.ParseInt(s, 10, t.Bits())
+		if err != nil {
+			return nil, err
+		}
+		value.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := strconv.ParseUint(s, 10, t.Bits())
+		if err != nil {
+			return nil, err
+		}
+		value.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, t.Bits())
+		if err != nil {
+			return nil, err
+		}
+		value.SetFloat(f)
+	default:
+		return nil, ErrRuleNotApplicable
+	}
+	return defaultValueValidator{value}, nil
+}
+
+func ValidateAndFill(v any) error {
+	return defaultValidator.ValidateAndFill(v)
+}
+
+func (val *Validator) ValidateAndFill(v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr {
+		return ErrNotPointer
+	}
+	vv, ok := indirect(rv)
+	if !ok {
+		return ErrNilPointer
+	}
+	if vv.Kind() != reflect.Struct {
+		return ErrNotStruct
+	}
+	var fills []func()
+	if err := val.fillStruct(vv, &fills, make(map[visit]bool)); err != nil {
+		return ValidationErrors{nestError("", err)}
+	}
+	for _, fill := range fills {
+		fill()
+	}
+	return val.Validate(v)
+}
+
+func (val *Validator) fillStruct(vv reflect.Value, fills *[]func(), visited map[visit]bool) error {
+	if _, ok := enter(visited, vv); !ok {
+		return nil
+	}
+	t := vv.Type()
+	names := val.fieldNames(t)
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		fv := vv.Field(i)
+		name := names[i]
+		if f.Anonymous {
+			fv = exported(fv)
+			if indirectType(f.Type).Kind() == reflect.Struct && !isValidatable(f.Type) {
+				name = ""
+			}
+		} else if !f.IsExported() {
+			continue
+		}
+		if tag, ok := f.Tag.Lookup(val.tagName); ok && isTaggable(f.Type) {
+			validators, err := val.parseValidators(t, i, f.Type, tag)
+			if err != nil {
+				return nestError(name, err)
+			}
+			for _, validator := range validators {
+				if d, ok := validator.(defaultValueValidator); ok {
+					*fills = append(*fills, func() { d.fill(fv) })
+				}
+			}
+		}
+		if !isValidatable(f.Type) {
+			if err := val.fillValue(fv, fills, visited); err != nil {
+				return nestError(name, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (val *Validator) fillValue(v reflect.Value, fills *[]func(), visited map[visit]bool) error {
+	v, ok := indirect(v)
+	if !ok {
+		return nil
+	}
+	switch v.Kind() {
+	case reflect.Struct:
+		if !isValidatable(v.Type()) {
+			return val.fillStruct(v, fills, visited)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if err := val.fillValue(v.Index(i), fills, visited); err != nil {
+				return nestError(fmt.Sprintf("[%d]", i), err)
+			}
+		}
+	case reflect.Map:
+		for _, k := range sortedKeys(v) {
+			k := k
+			elem := reflect.New(v.Type().Elem()).Elem()
+			elem.Set(v.MapIndex(k))
+			if err := val.fillValue(elem, fills, visited); err != nil {
+				return nestError(keyIndex(k), err)
+			}
+			*fills = append(*fills, func() { v.SetMapIndex(k, elem) })
+		}
+	}
+	return nil
+}
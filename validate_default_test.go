@@ -0,0 +1,216 @@
+package validator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type defaultPaging struct {
+	Limit int `validate:"default:50;max:500"`
+}
+
+type defaultQuery struct {
+	defaultPaging
+	Sort    string        `validate:"default:asc;in:asc,desc"`
+	Exact   bool          `validate:"default:true"`
+	Ratio   float32       `validate:"default:0.5;max:1"`
+	Retries *uint8        `validate:"default:3"`
+	Timeout time.Duration `validate:"default:1m30s;max:5m"`
+	Filters []defaultFilter
+	Nested  *defaultFilter
+	Scopes  map[string]defaultFilter
+	Refs    map[string]*defaultFilter
+}
+
+type defaultFilter struct {
+	Op string `validate:"default:eq;in:eq,ne"`
+}
+
+func TestValidateAndFill(t *testing.T) {
+	retries := uint8(3)
+	q := defaultQuery{
+		Filters: []defaultFilter{{}, {Op: "ne"}},
+		Nested:  &defaultFilter{},
+		Scopes:  map[string]defaultFilter{"a": {}, "b": {Op: "ne"}},
+		Refs:    map[string]*defaultFilter{"a": {}, "nil": nil},
+	}
+	require.NoError(t, ValidateAndFill(&q))
+	assert.Equal(t, defaultQuery{
+		defaultPaging: defaultPaging{Limit: 50},
+		Sort:          "asc",
+		Exact:         true,
+		Ratio:         0.5,
+		Retries:       &retries,
+		Timeout:       90 * time.Second,
+		Filters:       []defaultFilter{{Op: "eq"}, {Op: "ne"}},
+		Nested:        &defaultFilter{Op: "eq"},
+		Scopes:        map[string]defaultFilter{"a": {Op: "eq"}, "b": {Op: "ne"}},
+		Refs:          map[string]*defaultFilter{"a": {Op: "eq"}, "nil": nil},
+	}, q)
+
+	other := defaultQuery{}
+	require.NoError(t, ValidateAndFill(&other))
+	assert.NotSame(t, q.Retries, other.Retries)
+
+	tests := []struct {
+		name    string
+		v       any
+		want    any
+		wantErr string
+	}{
+		{
+			name: "non-zero kept",
+			v:    &defaultPaging{Limit: 10},
+			want: &defaultPaging{Limit: 10},
+		},
+		{
+			name:    "non-zero still validated",
+			v:       &defaultPaging{Limit: 1000},
+			want:    &defaultPaging{Limit: 1000},
+			wantErr: "1000 is higher than max allowed 500",
+		},
+		{
+			name: "default violates rules",
+			v: &struct {
+				Sort string `validate:"default:up;in:asc,desc"`
+			}{},
+			want: &struct {
+				Sort string `validate:"default:up;in:asc,desc"`
+			}{Sort: "up"},
+			wantErr: "up is not in [asc desc]",
+		},
+		{
+			name: "invalid param",
+			v: &struct {
+				Limit int8 `validate:"default:300"`
+			}{},
+			want: &struct {
+				Limit int8 `validate:"default:300"`
+			}{},
+			wantErr: ErrInvalidValidatorSyntax.Error(),
+		},
+		{
+			name: "invalid bool",
+			v: &struct {
+				Exact bool `validate:"default:1"`
+			}{},
+			want: &struct {
+				Exact bool `validate:"default:1"`
+			}{},
+			wantErr: ErrInvalidValidatorSyntax.Error(),
+		},
+		{
+			name: "duplicate default",
+			v: &struct {
+				Limit int `validate:"default:1;default:2"`
+			}{},
+			want: &struct {
+				Limit int `validate:"default:1;default:2"`
+			}{},
+			wantErr: ErrInvalidValidatorSyntax.Error(),
+		},
+		{
+			name: "unsupported type",
+			v: &struct {
+				At time.Time `validate:"default:now"`
+			}{},
+			want: &struct {
+				At time.Time `validate:"default:now"`
+			}{},
+			wantErr: ErrInvalidValidatorSyntax.Error(),
+		},
+		{
+			name: "dive element",
+			v: &struct {
+				Tags []string `validate:"dive;default:x"`
+			}{Tags: []string{""}},
+			want: &struct {
+				Tags []string `validate:"dive;default:x"`
+			}{Tags: []string{""}},
+			wantErr: ErrInvalidValidatorSyntax.Error(),
+		},
+		{
+			name:    "not a pointer",
+			v:       defaultPaging{},
+			want:    defaultPaging{},
+			wantErr: ErrNotPointer.Error(),
+		},
+		{
+			name:    "nil pointer",
+			v:       (*defaultPaging)(nil),
+			want:    (*defaultPaging)(nil),
+			wantErr: ErrNilPointer.Error(),
+		},
+		{
+			name:    "not a struct",
+			v:       new(int),
+			want:    new(int),
+			wantErr: ErrNotStruct.Error(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateAndFill(tt.v)
+			if len(tt.wantErr) != 0 {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, tt.v)
+		})
+	}
+}
+
+func TestDefaultWithoutFill(t *testing.T) {
+	p := defaultPaging{}
+	assert.NoError(t, Validate(&p))
+	assert.Zero(t, p.Limit)
+	assert.NoError(t, ValidateVar(0, "default:5;max:3"))
+	assert.ErrorIs(t, ValidateVar(0, "default:abc"), ErrInvalidValidatorSyntax)
+}
+
+func TestValidateAndFillInvalidTag(t *testing.T) {
+	type item struct {
+		Op    string `validate:"default:eq"`
+		Limit int8   `validate:"default:300"`
+	}
+	v := struct {
+		Sort  string `validate:"default:asc"`
+		Items []item
+		Refs  map[string]*item
+	}{Items: []item{{}}, Refs: map[string]*item{"a": {}}}
+
+	err := ValidateAndFill(&v)
+	require.Error(t, err)
+	errs := err.(ValidationErrors)
+	require.Len(t, errs, 1)
+	assert.Equal(t, "Items[0].Limit", errs[0].Field)
+	assert.ErrorIs(t, errs[0], ErrInvalidValidatorSyntax)
+	assert.Empty(t, v.Sort)
+	assert.Equal(t, []item{{}}, v.Items)
+	assert.Equal(t, &item{}, v.Refs["a"])
+
+	refs := struct {
+		Refs map[string]*item
+	}{Refs: map[string]*item{"c": {}, "a": {}, "b": {}}}
+	for i := 0; i < 10; i++ {
+		err := ValidateAndFill(&refs)
+		require.Error(t, err)
+		assert.Equal(t, "Refs[a].Limit", err.(ValidationErrors)[0].Field)
+	}
+}
+
+func TestValidateAndFillCyclic(t *testing.T) {
+	type node struct {
+		Op   string `validate:"default:eq"`
+		Next *node
+	}
+	n := &node{}
+	n.Next = n
+	require.NoError(t, ValidateAndFill(n))
+	assert.Equal(t, "eq", n.Op)
+	assert.Same(t, n, n.Next)
+}
@@ -0,0 +1,376 @@
+package validator
+
+import (
+	"fmt"
+	"github.com/pkg/errors"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+type crossFieldValidator interface {
+	fieldValidator
+	crossField()
+}
+
+type crossFieldValidatorCreator func(parent reflect.Type, index int, param string) (fieldValidator, error)
+
+type crossFieldRuleValidator struct {
+	rule      string
+	param     string
+	validator fieldValidator
+}
+
+func newCrossFieldRuleValidator(parent reflect.Type, index int, rule, param string, create crossFieldValidatorCreator) (fieldValidator, error) {
+	validator, err := create(parent, index, param)
+	if err != nil {
+		return nil, err
+	}
+	return crossFieldRuleValidator{rule, param, validator}, nil
+}
+
+func (v crossFieldRuleValidator) crossField() {}
+
+func (v crossFieldRuleValidator) validate(parent reflect.Value) error {
+	err := v.validator.validate(parent)
+	if err == nil {
+		return nil
+	}
+	return ValidationError{Rule: v.rule, Param: v.param, Err: err}
+}
+
+func siblingField(parent reflect.Type, name string) (reflect.StructField, error) {
+	if parent == nil || len(name) == 0 {
+		return reflect.StructField{}, ErrInvalidValidatorSyntax
+	}
+	f, ok := parent.FieldByName(name)
+	if !ok || len(f.Index) != 1 || !f.IsExported() {
+		return reflect.StructField{}, fmt.Errorf("unknown field %s", name)
+	}
+	return f, nil
+}
+
+func isComparableKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+func equalValues(a, b reflect.Value) bool {
+	switch a.Kind() {
+	case reflect.String:
+		return a.String() == b.String()
+	case reflect.Bool:
+		return a.Bool() == b.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return a.Int() == b.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return a.Uint() == b.Uint()
+	}
+	return a.Float() == b.Float()
+}
+
+type eqFieldValidator struct {
+	index, other int
+	otherName    string
+	ne           bool
+}
+
+func (v eqFieldValidator) crossField() {}
+
+func (v eqFieldValidator) validate(parent reflect.Value) error {
+	equal := equalValues(parent.Field(v.index), parent.Field(v.other))
+	switch {
+	case v.ne && equal:
+		return fmt.Errorf("must not equal %s", v.otherName)
+	case !v.ne && !equal:
+		return fmt.Errorf("must equal %s", v.otherName)
+	}
+	return nil
+}
+
+func newEqFieldValidator(parent reflect.Type, index int, s string) (fieldValidator, error) {
+	return newFieldEqualityValidator(parent, index, s, false)
+}
+
+func newNeFieldValidator(parent reflect.Type, index int, s string) (fieldValidator, error) {
+	return newFieldEqualityValidator(parent, index, s, true)
+}
+
+func newFieldEqualityValidator(parent reflect.Type, index int, s string, ne bool) (fieldValidator, error) {
+	other, err := siblingField(parent, s)
+	if err != nil {
+		return nil, err
+	}
+	t := parent.Field(index).Type
+	if other.Type != t || !isComparableKind(t.Kind()) {
+		return nil, errors.New("fields are not comparable")
+	}
+	return eqFieldValidator{index, other.Index[0], other.Name, ne}, nil
+}
+
+type numberClass int
+
+const (
+	notNumber numberClass = iota
+	signedNumber
+	unsignedNumber
+	floatNumber
+)
+
+func numberClassOf(k reflect.Kind) numberClass {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return signedNumber
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return unsignedNumber
+	case reflect.Float32, reflect.Float64:
+		return floatNumber
+	}
+	return notNumber
+}
+
+func isOrderedType(t reflect.Type) bool {
+	return numberClassOf(t.Kind()) != notNumber || t.Kind() == reflect.Struct && t.ConvertibleTo(timeType)
+}
+
+func compareValues(a, b reflect.Value) int {
+	if a.Kind() == reflect.Struct {
+		ta, tb := timeOf(a), timeOf(b)
+		switch {
+		case ta.Before(tb):
+			return -1
+		case ta.After(tb):
+			return 1
+		}
+		return 0
+	}
+	ca, cb := numberClassOf(a.Kind()), numberClassOf(b.Kind())
+	switch {
+	case ca == floatNumber || cb == floatNumber:
+		return compareOrdered(asFloat(a), asFloat(b))
+	case ca == signedNumber && cb == signedNumber:
+		return compareOrdered(a.Int(), b.Int())
+	case ca == unsignedNumber && cb == unsignedNumber:
+		return compareOrdered(a.Uint(), b.Uint())
+	case ca == signedNumber && a.Int() < 0:
+		return -1
+	case cb == signedNumber && b.Int() < 0:
+		return 1
+	}
+	return compareOrdered(asUint(a), asUint(b))
+}
+
+func compareOrdered[T int64 | uint64 | float64](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func asFloat(v reflect.Value) float64 {
+	switch numberClassOf(v.Kind()) {
+	case signedNumber:
+		return float64(v.Int())
+	case unsignedNumber:
+		return float64(v.Uint())
+	}
+	return v.Float()
+}
+
+func asUint(v reflect.Value) uint64 {
+	if numberClassOf(v.Kind()) == signedNumber {
+		return uint64(v.Int())
+	}
+	return v.Uint()
+}
+
+type orderFieldValidator struct {
+	index, other int
+	otherName    string
+	greater      bool
+}
+
+func (v orderFieldValidator) crossField() {}
+
+func (v orderFieldValidator) validate(parent reflect.Value) error {
+	val, other := parent.Field(v.index), parent.Field(v.other)
+	cmp := compareValues(val, other)
+	switch {
+	case v.greater && cmp <= 0:
+		return fmt.Errorf("%v must be greater than %s (%v)", val, v.otherName, other)
+	case !v.greater && cmp >= 0:
+		return fmt.Errorf("%v must be less than %s (%v)", val, v.otherName, other)
+	}
+	return nil
+}
+
+func newGtFieldValidator(parent reflect.Type, index int, s string) (fieldValidator, error) {
+	return newFieldOrderValidator(parent, index, s, true)
+}
+
+func newLtFieldValidator(parent reflect.Type, index int, s string) (fieldValidator, error) {
+	return newFieldOrderValidator(parent, index, s, false)
+}
+
+func newFieldOrderValidator(parent reflect.Type, index int, s string, greater bool) (fieldValidator, error) {
+	other, err := siblingField(parent, s)
+	if err != nil {
+		return nil, err
+	}
+	t := parent.Field(index).Type
+	isTime := t.Kind() == reflect.Struct
+	if !isOrderedType(t) || !isOrderedType(other.Type) || isTime != (other.Type.Kind() == reflect.Struct) {
+		return nil, errors.New("fields are not ordered")
+	}
+	return orderFieldValidator{index, other.Index[0], other.Name, greater}, nil
+}
+
+type requiredIfValidator struct {
+	index, other int
+	otherName    string
+	value        string
+}
+
+func (v requiredIfValidator) crossField() {}
+
+func (v requiredIfValidator) validate(parent reflect.Value) error {
+	other := parent.Field(v.other)
+	var repr string
+	switch numberClassOf(other.Kind()) {
+	case signedNumber:
+		repr = strconv.FormatInt(other.Int(), 10)
+	case unsignedNumber:
+		repr = strconv.FormatUint(other.Uint(), 10)
+	default:
+		repr = other.String()
+	}
+	if repr == v.value && parent.Field(v.index).IsZero() {
+		return fmt.Errorf("is required when %s is %s", v.otherName, v.value)
+	}
+	return nil
+}
+
+func newRequiredIfValidator(parent reflect.Type, index int, s string) (fieldValidator, error) {
+	name, value, ok := strings.Cut(s, "=")
+	if !ok {
+		return nil, ErrInvalidValidatorSyntax
+	}
+	other, err := siblingField(parent, name)
+	if err != nil {
+		return nil, err
+	}
+	switch numberClassOf(other.Type.Kind()) {
+	case signedNumber:
+		val, err := parseInt(value)
+		if err != nil {
+			return nil, err
+		}
+		value = strconv.FormatInt(val, 10)
+	case unsignedNumber:
+		val, err := parseUint(value)
+		if err != nil {
+			return nil, err
+		}
+		value = strconv.FormatUint(val, 10)
+	default:
+		if other.Type.Kind() != reflect.String {
+			return nil, errors.New("condition field must be a string or an integer")
+		}
+	}
+	return requiredIfValidator{index, other.Index[0], other.Name, value}, nil
+}
+
+type requiredWithValidator struct {
+	index  int
+	others []int
+	names  []string
+	with   bool
+}
+
+func (v requiredWithValidator) crossField() {}
+
+func (v requiredWithValidator) validate(parent reflect.Value) error {
+	if !parent.Field(v.index).IsZero() {
+		return nil
+	}
+	for i, other := range v.others {
+		set := !parent.Field(other).IsZero()
+		switch {
+		case v.with && set:
+			return fmt.Errorf("is required when %s is set", v.names[i])
+		case !v.with && !set:
+			return fmt.Errorf("is required when %s is not set", v.names[i])
+		}
+	}
+	return nil
+}
+
+func newRequiredWithValidator(parent reflect.Type, index int, s string) (fieldValidator, error) {
+	others, names, err := siblingFields(parent, s)
+	if err != nil {
+		return nil, err
+	}
+	return requiredWithValidator{index, others, names, true}, nil
+}
+
+func newRequiredWithoutValidator(parent reflect.Type, index int, s string) (fieldValidator, error) {
+	others, names, err := siblingFields(parent, s)
+	if err != nil {
+		return nil, err
+	}
+	return requiredWithValidator{index, others, names, false}, nil
+}
+
+func siblingFields(parent reflect.Type, s string) ([]int, []string, error) {
+	if len(s) == 0 {
+		return nil, nil, ErrInvalidValidatorSyntax
+	}
+	var indexes []int
+	var names []string
+	for _, name := range parseStrSlice(s) {
+		f, err := siblingField(parent, name)
+		if err != nil {
+			return nil, nil, err
+		}
+		indexes = append(indexes, f.Index[0])
+		names = append(names, f.Name)
+	}
+	return indexes, names, nil
+}
+
+type excludedWithValidator struct {
+	index  int
+	others []int
+	names  []string
+}
+
+func (v excludedWithValidator) crossField() {}
+
+func (v excludedWithValidator) validate(parent reflect.Value) error {
+	if parent.Field(v.index).IsZero() {
+		return nil
+	}
+	for i, other := range v.others {
+		if !parent.Field(other).IsZero() {
+			return fmt.Errorf("must be empty when %s is set", v.names[i])
+		}
+	}
+	return nil
+}
+
+func newExcludedWithValidator(parent reflect.Type, index int, s string) (fieldValidator, error) {
+	others, names, err := siblingFields(parent, s)
+	if err != nil {
+		return nil, err
+	}
+	return excludedWithValidator{index, others, names}, nil
+}
@@ -0,0 +1,377 @@
+package validator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateCrossFieldRules(t *testing.T) {
+	type args struct {
+		v any
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantErr  bool
+		checkErr func(err error) bool
+	}{
+		{
+			name: "valid struct with eqfield fields",
+			args: args{
+				v: struct {
+					Password        string
+					PasswordConfirm string `validate:"eqfield:Password"`
+					Total           int
+					Sum             int `validate:"eqfield:Total"`
+				}{
+					Password:        "s3cret",
+					PasswordConfirm: "s3cret",
+					Total:           10,
+					Sum:             10,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong eqfield fields",
+			args: args{
+				v: struct {
+					Password        string
+					PasswordConfirm string `validate:"eqfield:Password"`
+					Total           int
+					Sum             int    `validate:"eqfield:Total"`
+					Unknown         string `validate:"eqfield:Missing"`
+					Mismatch        int64  `validate:"eqfield:Total"`
+					secret          string
+					Unexported      string `validate:"eqfield:secret"`
+				}{
+					Password:        "s3cret",
+					PasswordConfirm: "s3cre7",
+					Total:           10,
+					Sum:             9,
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 5)
+				assert.Equal(t, "PasswordConfirm: must equal Password", errs[0].Error())
+				assert.NotContains(t, errs[0].Error(), "s3cre")
+				assert.Equal(t, "Sum: must equal Total", errs[1].Error())
+				for _, e := range errs[2:] {
+					assert.ErrorIs(t, e.Err, ErrInvalidValidatorSyntax)
+				}
+				return true
+			},
+		},
+		{
+			name: "valid struct with nefield fields",
+			args: args{
+				v: struct {
+					OldPassword string
+					NewPassword string `validate:"nefield:OldPassword"`
+					From        uint
+					To          uint `validate:"nefield:From"`
+				}{
+					OldPassword: "hunter2",
+					NewPassword: "hunter3",
+					From:        1,
+					To:          2,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong nefield fields",
+			args: args{
+				v: struct {
+					OldPassword string
+					NewPassword string `validate:"nefield:OldPassword"`
+					From        uint
+					To          uint   `validate:"nefield:From"`
+					Unknown     string `validate:"nefield:Missing"`
+					Mismatch    string `validate:"nefield:From"`
+				}{
+					OldPassword: "hunter2",
+					NewPassword: "hunter2",
+					From:        1,
+					To:          1,
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 4)
+				assert.Equal(t, "NewPassword: must not equal OldPassword", errs[0].Error())
+				assert.NotContains(t, errs[0].Error(), "hunter2")
+				assert.Equal(t, "To: must not equal From", errs[1].Error())
+				assert.ErrorIs(t, errs[2].Err, ErrInvalidValidatorSyntax)
+				assert.ErrorIs(t, errs[3].Err, ErrInvalidValidatorSyntax)
+				return true
+			},
+		},
+		{
+			name: "valid struct with gtfield and ltfield fields",
+			args: args{
+				v: struct {
+					StartPage int
+					EndPage   int `validate:"gtfield:StartPage"`
+					Limit     int64
+					Used      uint8 `validate:"ltfield:Limit"`
+					Min       float64
+					Max       int `validate:"gtfield:Min"`
+					Starts    time.Time
+					Ends      time.Time `validate:"gtfield:Starts"`
+				}{
+					StartPage: 3,
+					EndPage:   7,
+					Limit:     10,
+					Used:      9,
+					Min:       1.5,
+					Max:       2,
+					Starts:    time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+					Ends:      time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong gtfield and ltfield fields",
+			args: args{
+				v: struct {
+					StartPage int
+					EndPage   int `validate:"gtfield:StartPage"`
+					Offset    int
+					Count     uint `validate:"gtfield:Offset"`
+					Limit     int64
+					Used      uint8 `validate:"ltfield:Limit"`
+					Min       float64
+					Max       int `validate:"gtfield:Min"`
+					Starts    time.Time
+					Ends      time.Time `validate:"gtfield:Starts"`
+					Name      string    `validate:"gtfield:StartPage"`
+					Page      int       `validate:"ltfield:Name"`
+					When      time.Time `validate:"gtfield:StartPage"`
+					Missing   int       `validate:"ltfield:Nope"`
+				}{
+					StartPage: 7,
+					EndPage:   3,
+					Offset:    -1,
+					Count:     0,
+					Limit:     10,
+					Used:      10,
+					Min:       2.5,
+					Max:       2,
+					Starts:    time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC),
+					Ends:      time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 8)
+				assert.Equal(t, "EndPage: 3 must be greater than StartPage (7)", errs[0].Error())
+				assert.Equal(t, "Used: 10 must be less than Limit (10)", errs[1].Error())
+				assert.Equal(t, "Max: 2 must be greater than Min (2.5)", errs[2].Error())
+				assert.Contains(t, errs[3].Error(), "must be greater than Starts")
+				for _, e := range errs[4:] {
+					assert.ErrorIs(t, e.Err, ErrInvalidValidatorSyntax)
+				}
+				return true
+			},
+		},
+		{
+			name: "valid struct with required_if fields",
+			args: args{
+				v: struct {
+					Type       string
+					CardNumber string `validate:"required_if:Type=card"`
+					IBAN       string `validate:"required_if:Type=sepa"`
+					Version    int
+					Checksum   []byte `validate:"required_if:Version=2"`
+				}{
+					Type:       "card",
+					CardNumber: "4111111111111111",
+					Version:    1,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong required_if fields",
+			args: args{
+				v: struct {
+					Type       string
+					CardNumber string `validate:"required_if:Type=card"`
+					Version    uint
+					Checksum   []byte `validate:"required_if:Version=2"`
+					NoValue    string `validate:"required_if:Type"`
+					Unknown    string `validate:"required_if:Kind=card"`
+					NotInt     string `validate:"required_if:Version=two"`
+					Ratio      float64
+					ByFloat    string `validate:"required_if:Ratio=1"`
+				}{
+					Type:    "card",
+					Version: 2,
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 6)
+				assert.Equal(t, "CardNumber: is required when Type is card", errs[0].Error())
+				assert.Equal(t, "Checksum: is required when Version is 2", errs[1].Error())
+				for _, e := range errs[2:] {
+					assert.ErrorIs(t, e.Err, ErrInvalidValidatorSyntax)
+				}
+				return true
+			},
+		},
+		{
+			name: "valid struct with required_with and required_without fields",
+			args: args{
+				v: struct {
+					Email    string `validate:"required_without:Phone"`
+					Phone    string `validate:"required_without:Email"`
+					Street   string
+					City     string
+					Zip      string `validate:"required_with:Street,City"`
+					Password *string
+					Salt     []byte `validate:"required_with:Password"`
+				}{
+					Phone: "+15551234567",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong required_with and required_without fields",
+			args: args{
+				v: struct {
+					Email    string `validate:"required_without:Phone"`
+					Phone    string `validate:"required_without:Email"`
+					Street   string
+					City     string
+					Zip      string `validate:"required_with:Street,City"`
+					Password *string
+					Salt     []byte `validate:"required_with:Password"`
+					Empty    string `validate:"required_with:"`
+					Unknown  string `validate:"required_without:Email,Fax"`
+				}{
+					City:     "Berlin",
+					Password: strPtr(""),
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 6)
+				assert.Equal(t, "Email: is required when Phone is not set", errs[0].Error())
+				assert.Equal(t, "Phone: is required when Email is not set", errs[1].Error())
+				assert.Equal(t, "Zip: is required when City is set", errs[2].Error())
+				assert.Equal(t, "Salt: is required when Password is set", errs[3].Error())
+				assert.ErrorIs(t, errs[4].Err, ErrInvalidValidatorSyntax)
+				assert.ErrorIs(t, errs[5].Err, ErrInvalidValidatorSyntax)
+				return true
+			},
+		},
+		{
+			name: "valid struct with excluded_with fields",
+			args: args{
+				v: struct {
+					URL        string `validate:"excluded_with:InlineBody"`
+					InlineBody string
+				}{
+					InlineBody: "hello",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong excluded_with fields",
+			args: args{
+				v: struct {
+					URL        string `validate:"excluded_with:InlineBody,Template"`
+					InlineBody string
+					Template   string
+					Unknown    string `validate:"excluded_with:Missing"`
+				}{
+					URL:      "https://example.com/body",
+					Template: "welcome",
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 2)
+				assert.Equal(t, "URL: must be empty when Template is set", errs[0].Error())
+				assert.ErrorIs(t, errs[1].Err, ErrInvalidValidatorSyntax)
+				return true
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.args.v)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.True(t, tt.checkErr(err), "test expect an error, but got wrong error type")
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateExactlyOneOf(t *testing.T) {
+	type notification struct {
+		TemplateID string `validate:"required_without:RawBody;excluded_with:RawBody"`
+		RawBody    string `validate:"required_without:TemplateID;excluded_with:TemplateID"`
+	}
+	tests := []struct {
+		name     string
+		v        notification
+		wantErrs []string
+	}{
+		{
+			name: "template only",
+			v:    notification{TemplateID: "welcome"},
+		},
+		{
+			name: "raw body only",
+			v:    notification{RawBody: "hello"},
+		},
+		{
+			name: "neither",
+			v:    notification{},
+			wantErrs: []string{
+				"TemplateID: is required when RawBody is not set",
+				"RawBody: is required when TemplateID is not set",
+			},
+		},
+		{
+			name: "both",
+			v:    notification{TemplateID: "welcome", RawBody: "hello"},
+			wantErrs: []string{
+				"TemplateID: must be empty when RawBody is set",
+				"RawBody: must be empty when TemplateID is set",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.v)
+			if len(tt.wantErrs) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			errs := err.(ValidationErrors)
+			assert.Len(t, errs, len(tt.wantErrs))
+			for i, want := range tt.wantErrs {
+				assert.Equal(t, want, errs[i].Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"github.com/pkg/errors"
+	"io/fs"
+	"os"
+	"reflect"
+)
+
+type statFuncKey struct{}
+
+func WithStatFunc(stat func(string) (fs.FileInfo, error)) Option {
+	return func(val *Validator) {
+		val.stat = stat
+	}
+}
+
+func WithFS(fsys fs.FS) Option {
+	return WithStatFunc(func(name string) (fs.FileInfo, error) {
+		return fs.Stat(fsys, name)
+	})
+}
+
+func (val *Validator) statContext(ctx context.Context) context.Context {
+	if val.stat == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, statFuncKey{}, val.stat)
+}
+
+type pathValidator struct {
+	dir bool
+}
+
+func (v pathValidator) validate(s reflect.Value) error {
+	return v.validateCtx(context.Background(), s)
+}
+
+func (v pathValidator) validateCtx(ctx context.Context, s reflect.Value) error {
+	stat, ok := ctx.Value(statFuncKey{}).(func(string) (fs.FileInfo, error))
+	if !ok {
+		stat = os.Stat
+	}
+	path := s.String()
+	info, err := stat(path)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		return fmt.Errorf("%s does not exist", path)
+	case err != nil:
+		return fmt.Errorf("%s cannot be accessed", path)
+	case v.dir && !info.IsDir():
+		return fmt.Errorf("%s is not a directory", path)
+	case !v.dir && !info.Mode().IsRegular():
+		return fmt.Errorf("%s is not a regular file", path)
+	}
+	return nil
+}
+
+func newFileValidator(s string) (fieldValidator, error) {
+	if len(s) != 0 {
+		return nil, ErrInvalidValidatorSyntax
+	}
+	return pathValidator{}, nil
+}
+
+func newDirValidator(s string) (fieldValidator, error) {
+	if len(s) != 0 {
+		return nil, ErrInvalidValidatorSyntax
+	}
+	return pathValidator{dir: true}, nil
+}
@@ -0,0 +1,122 @@
+package validator
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeFileInfo struct {
+	mode fs.FileMode
+}
+
+func (f fakeFileInfo) Name() string       { return "fake" }
+func (f fakeFileInfo) Size() int64        { return 0 }
+func (f fakeFileInfo) Mode() fs.FileMode  { return f.mode }
+func (f fakeFileInfo) ModTime() time.Time { return time.Time{} }
+func (f fakeFileInfo) IsDir() bool        { return f.mode.IsDir() }
+func (f fakeFileInfo) Sys() any           { return nil }
+
+func TestValidatePathRules(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "config.yaml")
+	assert.NoError(t, os.WriteFile(file, nil, 0o600))
+	fileLink := filepath.Join(dir, "config-link.yaml")
+	assert.NoError(t, os.Symlink(file, fileLink))
+	dirLink := filepath.Join(dir, "dir-link")
+	assert.NoError(t, os.Symlink(dir, dirLink))
+	dangling := filepath.Join(dir, "dangling")
+	assert.NoError(t, os.Symlink(filepath.Join(dir, "missing"), dangling))
+
+	type paths struct {
+		File string `validate:"file"`
+		Dir  string `validate:"dir"`
+	}
+	tests := []struct {
+		name     string
+		v        paths
+		wantErrs []string
+	}{
+		{
+			name: "existing file and dir",
+			v:    paths{File: file, Dir: dir},
+		},
+		{
+			name: "symlinks are followed",
+			v:    paths{File: fileLink, Dir: dirLink},
+		},
+		{
+			name: "swapped file and dir",
+			v:    paths{File: dir, Dir: file},
+			wantErrs: []string{
+				"File: " + dir + " is not a regular file",
+				"Dir: " + file + " is not a directory",
+			},
+		},
+		{
+			name: "missing paths",
+			v:    paths{File: dangling, Dir: filepath.Join(dir, "missing")},
+			wantErrs: []string{
+				"File: " + dangling + " does not exist",
+				"Dir: " + filepath.Join(dir, "missing") + " does not exist",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.v)
+			if len(tt.wantErrs) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			errs, ok := err.(ValidationErrors)
+			assert.True(t, ok)
+			assert.Len(t, errs, len(tt.wantErrs))
+			for i, want := range tt.wantErrs {
+				assert.Equal(t, want, errs[i].Error())
+			}
+		})
+	}
+
+	t.Run("stubbed stat", func(t *testing.T) {
+		t.Parallel()
+		v := New(WithStatFunc(func(name string) (fs.FileInfo, error) {
+			switch name {
+			case "/etc/app.conf":
+				return fakeFileInfo{}, nil
+			case "/var/lib/app":
+				return fakeFileInfo{mode: fs.ModeDir}, nil
+			}
+			return nil, fs.ErrPermission
+		}))
+		assert.NoError(t, v.Validate(paths{File: "/etc/app.conf", Dir: "/var/lib/app"}))
+		err := v.Validate(paths{File: "/root/secret", Dir: "/var/lib/app"})
+		assert.EqualError(t, err, "/root/secret cannot be accessed")
+		assert.NoError(t, v.ValidateVar("/var/lib/app", "dir"))
+		assert.EqualError(t, Validate(paths{File: "/etc/app.conf", Dir: "/var/lib/app"}), "File: /etc/app.conf does not exist\nDir: /var/lib/app does not exist")
+	})
+
+	t.Run("fs", func(t *testing.T) {
+		t.Parallel()
+		v := New(WithFS(fstest.MapFS{
+			"etc/app.conf": &fstest.MapFile{Data: []byte("debug=true")},
+			"var/lib/app":  &fstest.MapFile{Mode: fs.ModeDir},
+		}))
+		assert.NoError(t, v.Validate(paths{File: "etc/app.conf", Dir: "var/lib/app"}))
+		err := v.Validate(paths{File: "var/lib/app", Dir: "etc/missing"})
+		assert.EqualError(t, err, "File: var/lib/app is not a regular file\nDir: etc/missing does not exist")
+	})
+
+	t.Run("parameters are rejected", func(t *testing.T) {
+		err := Validate(struct {
+			File string `validate:"file:follow"`
+		}{})
+		errs := err.(ValidationErrors)
+		assert.ErrorIs(t, errs[0].Err, ErrInvalidValidatorSyntax)
+	})
+}
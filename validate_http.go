@@ -0,0 +1,176 @@
+package validator
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/pkg/errors"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+)
+
+const (
+	ErrCodeMalformedJSON        = "malformed_json"
+	ErrCodeValidationFailed     = "validation_failed"
+	ErrCodeUnsupportedMediaType = "unsupported_media_type"
+	ErrCodeRequestTooLarge      = "request_too_large"
+	ErrCodeRequestCanceled      = "request_canceled"
+	ErrCodeInternal             = "internal_error"
+)
+
+const statusClientClosedRequest = 499
+
+type DecodeOption func(*decodeOptions)
+
+type decodeOptions struct {
+	validator    *Validator
+	maxBodySize  int64
+	contentTypes []string
+}
+
+func WithDecodeValidator(val *Validator) DecodeOption {
+	return func(o *decodeOptions) {
+		o.validator = val
+	}
+}
+
+func WithMaxBodySize(n int64) DecodeOption {
+	return func(o *decodeOptions) {
+		o.maxBodySize = n
+	}
+}
+
+func WithContentTypes(types ...string) DecodeOption {
+	return func(o *decodeOptions) {
+		o.contentTypes = types
+	}
+}
+
+type ErrorResponse struct {
+	Code    string               `json:"error"`
+	Message string               `json:"message,omitempty"`
+	Fields  []ErrorResponseField `json:"fields,omitempty"`
+	status  int
+}
+
+type ErrorResponseField struct {
+	Field   string `json:"field"`
+	Rule    string `json:"rule,omitempty"`
+	Param   string `json:"param,omitempty"`
+	Message string `json:"message"`
+}
+
+func DecodeAndValidate[T any](w http.ResponseWriter, r *http.Request, opts ...DecodeOption) (T, bool) {
+	var v T
+	if resp := decodeAndValidate(w, r, &v, opts); resp != nil {
+		writeErrorResponse(w, resp)
+		return v, false
+	}
+	return v, true
+}
+
+func decodeAndValidate(w http.ResponseWriter, r *http.Request, v any, opts []DecodeOption) *ErrorResponse {
+	o := decodeOptions{validator: defaultValidator}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if len(o.contentTypes) != 0 && !acceptContentType(r.Header.Get("Content-Type"), o.contentTypes) {
+		return &ErrorResponse{
+			Code:    ErrCodeUnsupportedMediaType,
+			Message: fmt.Sprintf("content type %q is not supported", r.Header.Get("Content-Type")),
+			status:  http.StatusUnsupportedMediaType,
+		}
+	}
+	body := r.Body
+	if o.maxBodySize > 0 {
+		body = http.MaxBytesReader(w, body, o.maxBodySize)
+	}
+	if err := decodeJSON(body, v); err != nil {
+		var maxErr *http.MaxBytesError
+		if errors.As(err, &maxErr) {
+			return &ErrorResponse{
+				Code:    ErrCodeRequestTooLarge,
+				Message: fmt.Sprintf("request body exceeds %d bytes", maxErr.Limit),
+				status:  http.StatusRequestEntityTooLarge,
+			}
+		}
+		return &ErrorResponse{Code: ErrCodeMalformedJSON, Message: err.Error(), status: http.StatusBadRequest}
+	}
+	err := o.validator.ValidateCtx(r.Context(), v)
+	if err == nil {
+		return nil
+	}
+	if ctxErr := r.Context().Err(); ctxErr != nil {
+		status := statusClientClosedRequest
+		if ctxErr == context.DeadlineExceeded {
+			status = http.StatusRequestTimeout
+		}
+		return &ErrorResponse{Code: ErrCodeRequestCanceled, Message: ctxErr.Error(), status: status}
+	}
+	errs, ok := err.(ValidationErrors)
+	if !ok || errs.misconfigured() {
+		return &ErrorResponse{Code: ErrCodeInternal, status: http.StatusInternalServerError}
+	}
+	resp := &ErrorResponse{Code: ErrCodeValidationFailed, Fields: make([]ErrorResponseField, 0, len(errs)), status: http.StatusBadRequest}
+	for _, e := range errs {
+		field, msg := e.detail()
+		resp.Fields = append(resp.Fields, ErrorResponseField{field, e.Rule, e.Param, msg})
+	}
+	return resp
+}
+
+func (v ValidationErrors) misconfigured() bool {
+	for _, err := range v {
+		if errors.Is(err, ErrInvalidValidatorSyntax) || errors.Is(err, ErrValidateForUnexportedFields) {
+			return true
+		}
+	}
+	return false
+}
+
+func decodeJSON(r io.Reader, v any) error {
+	dec := json.NewDecoder(r)
+	var raw json.RawMessage
+	if err := dec.Decode(&raw); err != nil {
+		if err == io.EOF {
+			return errors.New("request body is empty")
+		}
+		return err
+	}
+	if bytes.Equal(raw, []byte("null")) {
+		return errors.New("request body must not be null")
+	}
+	if err := json.Unmarshal(raw, v); err != nil {
+		return err
+	}
+	if err := dec.Decode(&struct{}{}); err != io.EOF {
+		var maxErr *http.MaxBytesError
+		if errors.As(err, &maxErr) {
+			return err
+		}
+		return errors.New("request body must contain a single JSON value")
+	}
+	return nil
+}
+
+func acceptContentType(header string, types []string) bool {
+	mediaType, _, err := mime.ParseMediaType(header)
+	if err != nil {
+		return false
+	}
+	for _, t := range types {
+		if strings.EqualFold(mediaType, t) {
+			return true
+		}
+	}
+	return false
+}
+
+func writeErrorResponse(w http.ResponseWriter, resp *ErrorResponse) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(resp.status)
+	_ = json.NewEncoder(w).Encode(resp)
+}
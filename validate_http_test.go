@@ -0,0 +1,204 @@
+package validator
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type httpSignup struct {
+	Name string `json:"name" validate:"required"`
+	Age  int    `json:"age" validate:"min:18"`
+}
+
+func TestDecodeAndValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        string
+		contentType string
+		opts        []DecodeOption
+		wantOK      bool
+		wantStatus  int
+		wantBody    string
+	}{
+		{
+			name:       "valid",
+			body:       `{"name":"alice","age":30}`,
+			wantOK:     true,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "validation failed",
+			body:       `{"age":10}`,
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `{"error":"validation_failed","fields":[{"field":"Name","rule":"required","message":"is required"},{"field":"Age","rule":"min","param":"18","message":"10 is less than min allowed 18"}]}`,
+		},
+		{
+			name:       "json field names",
+			body:       `{"name":"alice","age":1}`,
+			opts:       []DecodeOption{WithDecodeValidator(New(WithJSONFieldNames()))},
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `{"error":"validation_failed","fields":[{"field":"age","rule":"min","param":"18","message":"1 is less than min allowed 18"}]}`,
+		},
+		{
+			name:       "malformed json",
+			body:       `{"name":`,
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `{"error":"malformed_json","message":"unexpected EOF"}`,
+		},
+		{
+			name:       "empty body",
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `{"error":"malformed_json","message":"request body is empty"}`,
+		},
+		{
+			name:       "null body",
+			body:       `null`,
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `{"error":"malformed_json","message":"request body must not be null"}`,
+		},
+		{
+			name:       "trailing data",
+			body:       `{"name":"alice","age":30}{}`,
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `{"error":"malformed_json","message":"request body must contain a single JSON value"}`,
+		},
+		{
+			name:        "content type accepted",
+			body:        `{"name":"alice","age":30}`,
+			contentType: "application/json; charset=utf-8",
+			opts:        []DecodeOption{WithContentTypes("application/json")},
+			wantOK:      true,
+			wantStatus:  http.StatusOK,
+		},
+		{
+			name:        "content type rejected",
+			body:        `{"name":"alice","age":30}`,
+			contentType: "text/plain",
+			opts:        []DecodeOption{WithContentTypes("application/json")},
+			wantStatus:  http.StatusUnsupportedMediaType,
+			wantBody:    `{"error":"unsupported_media_type","message":"content type \"text/plain\" is not supported"}`,
+		},
+		{
+			name:       "body too large",
+			body:       `{"name":"` + strings.Repeat("a", 64) + `","age":30}`,
+			opts:       []DecodeOption{WithMaxBodySize(32)},
+			wantStatus: http.StatusRequestEntityTooLarge,
+			wantBody:   `{"error":"request_too_large","message":"request body exceeds 32 bytes"}`,
+		},
+		{
+			name:       "invalid tag",
+			body:       `{}`,
+			opts:       []DecodeOption{WithDecodeValidator(New(WithTagName("json")))},
+			wantStatus: http.StatusInternalServerError,
+			wantBody:   `{"error":"internal_error"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(tt.body))
+			if len(tt.contentType) != 0 {
+				r.Header.Set("Content-Type", tt.contentType)
+			}
+			w := httptest.NewRecorder()
+
+			v, ok := DecodeAndValidate[httpSignup](w, r, tt.opts...)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.wantStatus, w.Code)
+			if tt.wantOK {
+				assert.Equal(t, httpSignup{Name: "alice", Age: 30}, v)
+				assert.Zero(t, w.Body.Len())
+				return
+			}
+			require.Equal(t, "application/json", w.Header().Get("Content-Type"))
+			assert.JSONEq(t, tt.wantBody, w.Body.String())
+		})
+	}
+}
+
+func TestDecodeAndValidatePointer(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		wantOK     bool
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "valid",
+			body:       `{"name":"alice","age":30}`,
+			wantOK:     true,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "null body",
+			body:       ` null `,
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `{"error":"malformed_json","message":"request body must not be null"}`,
+		},
+		{
+			name:       "empty body",
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `{"error":"malformed_json","message":"request body is empty"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(tt.body))
+			w := httptest.NewRecorder()
+
+			v, ok := DecodeAndValidate[*httpSignup](w, r)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.wantStatus, w.Code)
+			if tt.wantOK {
+				assert.Equal(t, &httpSignup{Name: "alice", Age: 30}, v)
+				return
+			}
+			assert.JSONEq(t, tt.wantBody, w.Body.String())
+		})
+	}
+}
+
+func TestDecodeAndValidateCanceled(t *testing.T) {
+	expired, cancelExpired := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancelExpired()
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "canceled",
+			ctx:        canceled,
+			wantStatus: 499,
+			wantBody:   `{"error":"request_canceled","message":"context canceled"}`,
+		},
+		{
+			name:       "deadline exceeded",
+			ctx:        expired,
+			wantStatus: http.StatusRequestTimeout,
+			wantBody:   `{"error":"request_canceled","message":"context deadline exceeded"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"name":"alice","age":30}`)).WithContext(tt.ctx)
+			w := httptest.NewRecorder()
+
+			_, ok := DecodeAndValidate[httpSignup](w, r)
+			assert.False(t, ok)
+			assert.Equal(t, tt.wantStatus, w.Code)
+			assert.JSONEq(t, tt.wantBody, w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package validator
+
+import (
+	"strings"
+)
+
+func SetMessage(rule, template string) {
+	defaultValidator.SetMessage(rule, template)
+}
+
+func (val *Validator) SetMessage(rule, template string) {
+	val.mu.Lock()
+	defer val.mu.Unlock()
+	if val.messages == nil {
+		val.messages = make(map[string]string)
+	}
+	if len(template) == 0 {
+		delete(val.messages, rule)
+		return
+	}
+	val.messages[rule] = template
+}
+
+func (val *Validator) withMessages(errs ValidationErrors) ValidationErrors {
+	val.mu.RLock()
+	defer val.mu.RUnlock()
+	if len(val.messages) == 0 {
+		return errs
+	}
+	for i, err := range errs {
+		errs[i] = withMessage(err, val.messages)
+	}
+	return errs
+}
+
+func withMessage(err ValidationError, messages map[string]string) ValidationError {
+	if inner, ok := err.Err.(ValidationError); ok {
+		err.Err = withMessage(inner, messages)
+		return err
+	}
+	if len(err.Rule) != 0 {
+		err.message = messages[err.Rule]
+	}
+	return err
+}
+
+func (v ValidationError) render(field string) string {
+	return strings.NewReplacer(
+		"{field}", field,
+		"{rule}", v.Rule,
+		"{param}", v.Param,
+		"{value}", v.Value,
+	).Replace(v.message)
+}
+
+type Translator interface {
+	Translate(rule, field, param, value string) string
+}
+
+type MessageTranslator map[string]string
+
+func (m MessageTranslator) Translate(rule, field, param, value string) string {
+	template, ok := m[rule]
+	if !ok {
+		return ""
+	}
+	return ValidationError{Rule: rule, Param: param, Value: value, message: template}.render(field)
+}
+
+var English = MessageTranslator{
+	"required": "{field} is required",
+	"min":      "{field} must be at least {param}",
+	"max":      "{field} must be at most {param}",
+	"len":      "{field} must have length {param}",
+	"gt":       "{field} must be greater than {param}",
+	"gte":      "{field} must be at least {param}",
+	"lt":       "{field} must be less than {param}",
+	"lte":      "{field} must be at most {param}",
+	"eq":       "{field} must be equal to {param}",
+	"ne":       "{field} must not be equal to {param}",
+	"in":       "{field} must be one of {param}",
+	"between":  "{field} must be between {param}",
+	"regexp":   "{field} has an invalid format",
+	"url":      "{field} must be a valid URL",
+	"uuid":     "{field} must be a valid UUID",
+	"eqfield":  "{field} must be equal to {param}",
+	"nefield":  "{field} must not be equal to {param}",
+}
+
+var Russian = MessageTranslator{
+	"required": "поле {field} обязательно",
+	"min":      "поле {field} должно быть не меньше {param}",
+	"max":      "поле {field} должно быть не больше {param}",
+	"len":      "поле {field} должно иметь длину {param}",
+	"gt":       "поле {field} должно быть больше {param}",
+	"gte":      "поле {field} должно быть не меньше {param}",
+	"lt":       "поле {field} должно быть меньше {param}",
+	"lte":      "поле {field} должно быть не больше {param}",
+	"eq":       "поле {field} должно быть равно {param}",
+	"ne":       "поле {field} не должно быть равно {param}",
+	"in":       "поле {field} должно быть одним из {param}",
+	"between":  "поле {field} должно быть в диапазоне {param}",
+	"regexp":   "поле {field} имеет неверный формат",
+	"url":      "поле {field} должно быть корректным URL",
+	"uuid":     "поле {field} должно быть корректным UUID",
+	"eqfield":  "поле {field} должно совпадать с {param}",
+	"nefield":  "поле {field} не должно совпадать с {param}",
+}
+
+func (v ValidationError) Translate(tr Translator) string {
+	field, leaf := v.leaf()
+	if len(leaf.Rule) != 0 {
+		if msg := tr.Translate(leaf.Rule, field, leaf.Param, leaf.Value); len(msg) != 0 {
+			return msg
+		}
+	}
+	return v.Error()
+}
+
+func (v ValidationErrors) Translate(tr Translator) []string {
+	msgs := make([]string, 0, len(v))
+	for _, err := range v {
+		msgs = append(msgs, err.Translate(tr))
+	}
+	return msgs
+}
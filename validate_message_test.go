@@ -0,0 +1,193 @@
+package validator
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetMessage(t *testing.T) {
+	type address struct {
+		Zip string `validate:"len:5"`
+	}
+	type signup struct {
+		Name    string `validate:"min:3"`
+		Age     int    `validate:"min:18"`
+		Email   string `validate:"max:5"`
+		Address address
+		Tags    []string `validate:"in:go,rust"`
+	}
+	s := signup{Name: "Al", Age: 7, Email: "alice@example.com", Address: address{Zip: "1"}, Tags: []string{"go", "c"}}
+
+	v := New()
+	v.SetMessage("min", "{field} must be at least {param}")
+	v.SetMessage("len", "{field} must have exactly {param} characters, got {value}")
+	v.SetMessage("in", "{field} must be one of {param}, {unknown} stays")
+
+	tests := []struct {
+		name  string
+		index int
+		want  string
+	}{
+		{"template with field and param", 0, "Name must be at least 3"},
+		{"same rule on another kind", 1, "Age must be at least 18"},
+		{"rule without template", 2, "Email: len of alice@example.com is higher than max allowed 5"},
+		{"nested field path", 3, "Address.Zip must have exactly 5 characters, got 1"},
+		{"indexed field with unknown placeholder", 4, "Tags[1] must be one of go,rust, {unknown} stays"},
+	}
+	errs, ok := v.Validate(s).(ValidationErrors)
+	assert.True(t, ok)
+	assert.Len(t, errs, len(tests))
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, errs[tt.index].Error())
+		})
+	}
+
+	data, err := json.Marshal(errs[3])
+	assert.NoError(t, err)
+	assert.Equal(t, `{"field":"Address.Zip","rule":"len","param":"5","error":"Address.Zip must have exactly 5 characters, got 1"}`, string(data))
+
+	v.SetMessage("gte", "must be {param} or more, got {value}")
+	assert.Equal(t, "must be 10 or more, got 3", v.ValidateVar(3, "gte:10").Error())
+
+	v.SetMessage("min", "")
+	assert.Equal(t, "Name: len of Al is less than min allowed 3", v.Validate(s).(ValidationErrors)[0].Error())
+	assert.Equal(t, "Name: len of Al is less than min allowed 3", New().Validate(s).(ValidationErrors)[0].Error())
+}
+
+type upperTranslator struct{}
+
+func (upperTranslator) Translate(rule, field, _, value string) string {
+	if rule != "in" {
+		return ""
+	}
+	return strings.ToUpper(field + " " + value)
+}
+
+func TestTranslate(t *testing.T) {
+	type address struct {
+		City string `validate:"required"`
+	}
+	type profile struct {
+		Name    string   `validate:"min:3"`
+		Age     int      `validate:"lte:120"`
+		Role    string   `validate:"in:admin,user"`
+		Address address  `json:"address"`
+		Codes   []string `validate:"hex"`
+	}
+	err := Validate(profile{Name: "Al", Age: 130, Role: "root", Codes: []string{"zz"}})
+	errs, ok := err.(ValidationErrors)
+	assert.True(t, ok)
+
+	tests := []struct {
+		name string
+		tr   Translator
+		want []string
+	}{
+		{
+			name: "english",
+			tr:   English,
+			want: []string{
+				"Name must be at least 3",
+				"Age must be at most 120",
+				"Role must be one of admin,user",
+				"Address.City is required",
+				"Codes[0]: is not valid hex",
+			},
+		},
+		{
+			name: "russian",
+			tr:   Russian,
+			want: []string{
+				"поле Name должно быть не меньше 3",
+				"поле Age должно быть не больше 120",
+				"поле Role должно быть одним из admin,user",
+				"поле Address.City обязательно",
+				"Codes[0]: is not valid hex",
+			},
+		},
+		{
+			name: "custom translator",
+			tr:   upperTranslator{},
+			want: []string{
+				"Name: len of Al is less than min allowed 3",
+				"Age: 130 is higher than max allowed 120",
+				"ROLE ROOT",
+				"Address.City: is required",
+				"Codes[0]: is not valid hex",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, errs.Translate(tt.tr))
+		})
+	}
+
+	structErr := ValidationError{Err: errors.New("discount cannot exceed subtotal")}
+	assert.Equal(t, "discount cannot exceed subtotal", structErr.Translate(English))
+	assert.Empty(t, ValidationErrors(nil).Translate(English))
+}
+
+type valueTranslator struct{}
+
+func (valueTranslator) Translate(rule, field, _, value string) string {
+	return rule + " " + field + " [" + value + "]"
+}
+
+func TestRedactedValues(t *testing.T) {
+	type payment struct {
+		Card     string `validate:"creditcard"`
+		Short    string `validate:"creditcard"`
+		Token    string `validate:"jwt"`
+		Password string `validate:"eqfield:Confirm"`
+		Confirm  string
+		Old      string `validate:"nefield:New"`
+		New      string
+	}
+	p := payment{
+		Card:     "4111 1111 1111 1112",
+		Short:    "12-34",
+		Token:    "header.payload",
+		Password: "secret",
+		Confirm:  "secrets",
+		Old:      "hunter2",
+		New:      "hunter2",
+	}
+
+	v := New()
+	v.SetMessage("creditcard", "{field}={value}")
+	v.SetMessage("jwt", "{field}={value}")
+	v.SetMessage("eqfield", "{field}={value}")
+	v.SetMessage("nefield", "{field}={value}")
+
+	tests := []struct {
+		field     string
+		value     string
+		message   string
+		translate string
+	}{
+		{"Card", "************1112", "Card=************1112", "creditcard Card [************1112]"},
+		{"Short", "****", "Short=****", "creditcard Short [****]"},
+		{"Token", "", "Token=", "jwt Token []"},
+		{"Password", "", "Password=", "eqfield Password []"},
+		{"Old", "", "Old=", "nefield Old []"},
+	}
+	errs, ok := v.Validate(p).(ValidationErrors)
+	assert.True(t, ok)
+	assert.Len(t, errs, len(tests))
+	translated := errs.Translate(valueTranslator{})
+	for i, tt := range tests {
+		t.Run(tt.field, func(t *testing.T) {
+			assert.Equal(t, tt.field, errs[i].Field)
+			assert.Equal(t, tt.value, errs[i].Value)
+			assert.Equal(t, tt.message, errs[i].Error())
+			assert.Equal(t, tt.translate, translated[i])
+		})
+	}
+	assert.Equal(t, "12345", New().ValidateVar(12345, "max:10").(ValidationErrors)[0].Value)
+}
@@ -0,0 +1,112 @@
+package validator
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"reflect"
+	"strings"
+)
+
+var ErrUnknownField = errors.New("unknown field")
+
+type fieldFilter struct {
+	fields  map[string]*fieldFilter
+	exclude bool
+}
+
+func newFieldFilter(t reflect.Type, paths []string, exclude bool) (*fieldFilter, error) {
+	root := &fieldFilter{make(map[string]*fieldFilter), exclude}
+	for _, path := range paths {
+		names := strings.Split(path, ".")
+		node, typ := root, t
+		for i, name := range names {
+			f, ok := fieldByPath(typ, name)
+			if !ok {
+				return nil, errors.Wrap(ErrUnknownField, path)
+			}
+			typ = f.Type
+			if node == nil {
+				continue
+			}
+			sub, seen := node.fields[name]
+			if i == len(names)-1 {
+				node.fields[name] = nil
+			} else if !seen {
+				sub = &fieldFilter{make(map[string]*fieldFilter), exclude}
+				node.fields[name] = sub
+			}
+			node = sub
+		}
+	}
+	return root, nil
+}
+
+func fieldByPath(t reflect.Type, name string) (reflect.StructField, bool) {
+	for {
+		switch t.Kind() {
+		case reflect.Ptr, reflect.Slice, reflect.Array, reflect.Map:
+			t = t.Elem()
+			continue
+		case reflect.Struct:
+			f, ok := t.FieldByName(name)
+			return f, ok && (f.IsExported() || f.Anonymous)
+		}
+		return reflect.StructField{}, false
+	}
+}
+
+func (f *fieldFilter) has(name string) bool {
+	if f == nil {
+		return false
+	}
+	_, ok := f.fields[name]
+	return ok
+}
+
+func (f *fieldFilter) lookup(name string) (nested *fieldFilter, rules, walk bool) {
+	if f == nil {
+		return nil, true, true
+	}
+	sub, ok := f.fields[name]
+	if f.exclude {
+		if !ok {
+			return nil, true, true
+		}
+		return sub, true, sub != nil
+	}
+	if !ok {
+		return nil, false, false
+	}
+	if sub == nil {
+		return nil, true, true
+	}
+	return sub, false, true
+}
+
+func ValidatePartial(v any, fields ...string) error {
+	return defaultValidator.ValidatePartial(v, fields...)
+}
+
+func (val *Validator) ValidatePartial(v any, fields ...string) error {
+	return val.validateFiltered(v, fields, false)
+}
+
+func ValidateExcept(v any, fields ...string) error {
+	return defaultValidator.ValidateExcept(v, fields...)
+}
+
+func (val *Validator) ValidateExcept(v any, fields ...string) error {
+	return val.validateFiltered(v, fields, true)
+}
+
+func (val *Validator) validateFiltered(v any, fields []string, exclude bool) error {
+	t := reflect.TypeOf(v)
+	if t == nil || indirectType(t).Kind() != reflect.Struct {
+		return ErrNotStruct
+	}
+	filter, err := newFieldFilter(indirectType(t), fields, exclude)
+	if err != nil {
+		return err
+	}
+	return val.validate(context.Background(), v, filter)
+}
@@ -0,0 +1,165 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+type patchAudit struct {
+	Author string `validate:"min:3"`
+}
+
+type patchAddress struct {
+	City string `validate:"min:2"`
+	Zip  string `validate:"len:5"`
+}
+
+type patchUser struct {
+	patchAudit
+	Name     string         `validate:"min:3"`
+	Email    string         `validate:"min:5"`
+	Address  *patchAddress  `validate:"required"`
+	Previous []patchAddress `validate:"maxitems:1"`
+}
+
+func TestValidatePartial(t *testing.T) {
+	user := patchUser{
+		patchAudit: patchAudit{Author: "x"},
+		Name:       "Al",
+		Email:      "a@b",
+		Previous:   []patchAddress{{City: "B", Zip: "123"}, {City: "Rome", Zip: "00100"}},
+	}
+	tests := []struct {
+		name    string
+		fields  []string
+		wantErr []string
+	}{
+		{
+			name:    "single top-level field",
+			fields:  []string{"Name"},
+			wantErr: []string{"Name: len of Al is less than min allowed 3"},
+		},
+		{
+			name:    "several fields",
+			fields:  []string{"Email", "Address"},
+			wantErr: []string{"Email: len of a@b is less than min allowed 5", "Address: is required"},
+		},
+		{
+			name:   "dotted path skips parent rules",
+			fields: []string{"Previous.City"},
+			wantErr: []string{
+				"Previous[0].City: len of B is less than min allowed 2",
+			},
+		},
+		{
+			name:   "whole field wins over dotted path",
+			fields: []string{"Previous.Zip", "Previous"},
+			wantErr: []string{
+				"Previous: number of items 2 is higher than max allowed 1",
+				"Previous[0].City: len of B is less than min allowed 2",
+				"Previous[0].Zip: len of 123 is not equal to 5",
+			},
+		},
+		{
+			name:    "promoted field",
+			fields:  []string{"Author"},
+			wantErr: []string{"Author: len of x is less than min allowed 3"},
+		},
+		{
+			name:    "no matching errors",
+			fields:  []string{"Address.City"},
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidatePartial(user, tt.fields...)
+			if len(tt.wantErr) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			errs, ok := err.(ValidationErrors)
+			assert.True(t, ok)
+			got := make([]string, 0, len(errs))
+			for _, err := range errs {
+				got = append(got, err.Error())
+			}
+			assert.Equal(t, tt.wantErr, got)
+		})
+	}
+
+	assert.True(t, errors.Is(ValidatePartial(user, "Nmae"), ErrUnknownField))
+	assert.True(t, errors.Is(ValidatePartial(user, "Address.Street"), ErrUnknownField))
+	assert.True(t, errors.Is(ValidatePartial(user, "Name.Length"), ErrUnknownField))
+	assert.True(t, errors.Is(ValidatePartial("user", "Name"), ErrNotStruct))
+	assert.True(t, errors.Is(ValidatePartial((*patchUser)(nil), "Name"), ErrNilPointer))
+}
+
+func TestValidateExcept(t *testing.T) {
+	user := patchUser{
+		patchAudit: patchAudit{Author: "x"},
+		Name:       "Al",
+		Email:      "a@b",
+		Address:    &patchAddress{City: "Rome", Zip: "1"},
+		Previous:   []patchAddress{{City: "B", Zip: "123"}, {City: "Rome", Zip: "00100"}},
+	}
+	tests := []struct {
+		name    string
+		fields  []string
+		wantErr []string
+	}{
+		{
+			name:   "top-level fields",
+			fields: []string{"Author", "Name", "Email"},
+			wantErr: []string{
+				"Address.Zip: len of 1 is not equal to 5",
+				"Previous: number of items 2 is higher than max allowed 1",
+				"Previous[0].City: len of B is less than min allowed 2",
+				"Previous[0].Zip: len of 123 is not equal to 5",
+			},
+		},
+		{
+			name:   "struct field excludes everything beneath it",
+			fields: []string{"Author", "Name", "Email", "Previous"},
+			wantErr: []string{
+				"Address.Zip: len of 1 is not equal to 5",
+			},
+		},
+		{
+			name:   "dotted path keeps parent rules",
+			fields: []string{"Author", "Name", "Email", "Address.Zip", "Previous.City", "Previous.Zip"},
+			wantErr: []string{
+				"Previous: number of items 2 is higher than max allowed 1",
+			},
+		},
+		{
+			name:   "embedded struct",
+			fields: []string{"patchAudit", "Email", "Address", "Previous"},
+			wantErr: []string{
+				"Name: len of Al is less than min allowed 3",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateExcept(user, tt.fields...)
+			if len(tt.wantErr) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			errs, ok := err.(ValidationErrors)
+			assert.True(t, ok)
+			got := make([]string, 0, len(errs))
+			for _, err := range errs {
+				got = append(got, err.Error())
+			}
+			assert.Equal(t, tt.wantErr, got)
+		})
+	}
+
+	assert.True(t, errors.Is(ValidateExcept(user, "Address.Street"), ErrUnknownField))
+	assert.True(t, errors.Is(ValidateExcept(user, "Nam"), ErrUnknownField))
+	assert.True(t, errors.Is(ValidateExcept(42, "Name"), ErrNotStruct))
+}
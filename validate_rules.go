@@ -0,0 +1,73 @@
+package validator
+
+import (
+	"reflect"
+)
+
+type FieldRules struct {
+	Path  string
+	Rules []Rule
+}
+
+func Rules(t reflect.Type) ([]FieldRules, error) {
+	return defaultValidator.Rules(t)
+}
+
+func (val *Validator) Rules(t reflect.Type) ([]FieldRules, error) {
+	if t == nil || indirectType(t).Kind() != reflect.Struct {
+		return nil, ErrNotStruct
+	}
+	var fields []FieldRules
+	var errs ValidationErrors
+	val.collectRules(indirectType(t), "", map[reflect.Type]bool{}, &fields, &errs)
+	if len(errs) != 0 {
+		return fields, errs
+	}
+	return fields, nil
+}
+
+func (val *Validator) collectRules(t reflect.Type, prefix string, visited map[reflect.Type]bool, fields *[]FieldRules, errs *ValidationErrors) {
+	if visited[t] {
+		return
+	}
+	visited[t] = true
+	defer delete(visited, t)
+	names := val.fieldNames(t)
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.Anonymous && indirectType(f.Type).Kind() == reflect.Struct && !isValidatable(f.Type) {
+			val.collectRules(indirectType(f.Type), prefix, visited, fields, errs)
+			continue
+		}
+		if !f.IsExported() {
+			continue
+		}
+		path := joinField(prefix, names[i])
+		if tag, ok := f.Tag.Lookup(val.tagName); ok && isTaggable(f.Type) {
+			rules, err := ParseRules(tag)
+			if err == nil {
+				_, err = val.parseValidators(t, i, f.Type, tag)
+			}
+			if err != nil {
+				*errs = append(*errs, ValidationError{Field: path, Err: err})
+			} else {
+				*fields = append(*fields, FieldRules{path, rules})
+			}
+		}
+		elem, elemPath := f.Type, path
+		for {
+			if elem.Kind() == reflect.Ptr {
+				elem = elem.Elem()
+				continue
+			}
+			if k := elem.Kind(); k == reflect.Slice || k == reflect.Array || k == reflect.Map {
+				elem, elemPath = elem.Elem(), elemPath+"[]"
+				continue
+			}
+			break
+		}
+		if elem.Kind() == reflect.Struct && !isValidatable(elem) {
+			val.collectRules(elem, elemPath, visited, fields, errs)
+		}
+	}
+}
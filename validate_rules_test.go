@@ -0,0 +1,74 @@
+package validator
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+type rulesAudit struct {
+	CreatedBy string `validate:"required"`
+}
+
+type rulesAddress struct {
+	City string `validate:"min:2"`
+	Zip  string `json:"zip_code" validate:"len:5"`
+}
+
+type rulesUser struct {
+	rulesAudit
+	Name     string   `validate:"min:3;max:64"`
+	Age      int      `validate:"min:18"`
+	Role     string   `validate:"in:admin,user\\,guest"`
+	Tags     []string `validate:"max:3;dive;min:2"`
+	Password string   `validate:"eqfield:Confirm"`
+	Confirm  string
+	Birthday time.Time
+	Address  *rulesAddress
+	Previous []rulesAddress
+	Parent   *rulesUser
+	secret   string
+}
+
+func TestRules(t *testing.T) {
+	fields, err := Rules(reflect.TypeOf(&rulesUser{}))
+	assert.NoError(t, err)
+	assert.Equal(t, []FieldRules{
+		{"CreatedBy", []Rule{{"required", ""}}},
+		{"Name", []Rule{{"min", "3"}, {"max", "64"}}},
+		{"Age", []Rule{{"min", "18"}}},
+		{"Role", []Rule{{"in", "admin,user\\,guest"}}},
+		{"Tags", []Rule{{"max", "3"}, {"dive", ""}, {"min", "2"}}},
+		{"Password", []Rule{{"eqfield", "Confirm"}}},
+		{"Address.City", []Rule{{"min", "2"}}},
+		{"Address.Zip", []Rule{{"len", "5"}}},
+		{"Previous[].City", []Rule{{"min", "2"}}},
+		{"Previous[].Zip", []Rule{{"len", "5"}}},
+	}, fields)
+	assert.Equal(t, []string{"admin", "user,guest"}, fields[3].Rules[0].Params())
+
+	fields, err = New(WithJSONFieldNames()).Rules(reflect.TypeOf(rulesAddress{}))
+	assert.NoError(t, err)
+	assert.Equal(t, "zip_code", fields[1].Path)
+
+	type broken struct {
+		Good  string `validate:"min:1"`
+		Empty string `validate:"min:"`
+		Kind  int    `validate:"regexp:^a$"`
+		Other string `validate:"eqfield:Missing"`
+	}
+	fields, err = Rules(reflect.TypeOf(broken{}))
+	assert.Equal(t, []FieldRules{{"Good", []Rule{{"min", "1"}}}}, fields)
+	errs, ok := err.(ValidationErrors)
+	assert.True(t, ok)
+	assert.Equal(t, []string{"Empty", "Kind", "Other"}, errs.Fields())
+	assert.True(t, errors.Is(errs[0], ErrInvalidValidatorSyntax))
+
+	_, err = Rules(reflect.TypeOf(42))
+	assert.True(t, errors.Is(err, ErrNotStruct))
+	_, err = Rules(nil)
+	assert.True(t, errors.Is(err, ErrNotStruct))
+}
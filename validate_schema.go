@@ -0,0 +1,171 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"github.com/pkg/errors"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+func Required() Rule {
+	return Rule{Name: "required"}
+}
+
+func OmitEmpty() Rule {
+	return Rule{Name: "omitempty"}
+}
+
+func Dive() Rule {
+	return Rule{Name: "dive"}
+}
+
+func Min(v any) Rule {
+	return Rule{"min", fmt.Sprint(v)}
+}
+
+func Max(v any) Rule {
+	return Rule{"max", fmt.Sprint(v)}
+}
+
+func Len(v any) Rule {
+	return Rule{"len", fmt.Sprint(v)}
+}
+
+func Eq(v any) Rule {
+	return Rule{"eq", fmt.Sprint(v)}
+}
+
+func Ne(v any) Rule {
+	return Rule{"ne", fmt.Sprint(v)}
+}
+
+func Gt(v any) Rule {
+	return Rule{"gt", fmt.Sprint(v)}
+}
+
+func Gte(v any) Rule {
+	return Rule{"gte", fmt.Sprint(v)}
+}
+
+func Lt(v any) Rule {
+	return Rule{"lt", fmt.Sprint(v)}
+}
+
+func Lte(v any) Rule {
+	return Rule{"lte", fmt.Sprint(v)}
+}
+
+func In(values ...any) Rule {
+	params := make([]string, 0, len(values))
+	for _, v := range values {
+		params = append(params, strings.ReplaceAll(fmt.Sprint(v), ",", "\\,"))
+	}
+	return Rule{"in", strings.Join(params, ",")}
+}
+
+func Regexp(pattern string) Rule {
+	return Rule{"regexp", pattern}
+}
+
+var ErrSchemaCompiled = errors.New("schema is already compiled")
+
+type Schema[T any] struct {
+	val   *Validator
+	t     reflect.Type
+	rules map[int][]Rule
+	err   error
+	mu    sync.Mutex
+	state atomic.Pointer[schemaState]
+}
+
+type schemaState struct {
+	validators map[int][]fieldValidator
+	err        error
+}
+
+func NewSchema[T any]() *Schema[T] {
+	s := &Schema[T]{
+		val:   defaultValidator,
+		t:     reflect.TypeOf((*T)(nil)).Elem(),
+		rules: make(map[int][]Rule),
+	}
+	if s.t.Kind() != reflect.Struct {
+		s.err = ErrNotStruct
+	}
+	return s
+}
+
+func (s *Schema[T]) Field(name string, rules ...Rule) *Schema[T] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if st := s.state.Load(); st != nil {
+		if st.err == nil {
+			s.state.Store(&schemaState{err: errors.Wrap(ErrSchemaCompiled, name)})
+		}
+		return s
+	}
+	if s.err != nil {
+		return s
+	}
+	f, ok := s.t.FieldByName(name)
+	switch {
+	case !ok || len(f.Index) != 1:
+		s.err = errors.Wrap(ErrUnknownField, name)
+	case !f.IsExported():
+		s.err = errors.Wrap(ErrValidateForUnexportedFields, name)
+	default:
+		s.rules[f.Index[0]] = append(s.rules[f.Index[0]], rules...)
+	}
+	return s
+}
+
+func (s *Schema[T]) Compile() error {
+	return s.compiled().err
+}
+
+func (s *Schema[T]) compiled() *schemaState {
+	if st := s.state.Load(); st != nil {
+		return st
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if st := s.state.Load(); st != nil {
+		return st
+	}
+	st := s.compile()
+	s.state.Store(st)
+	return st
+}
+
+func (s *Schema[T]) compile() *schemaState {
+	if s.err != nil {
+		return &schemaState{err: s.err}
+	}
+	indexes := make([]int, 0, len(s.rules))
+	for index := range s.rules {
+		indexes = append(indexes, index)
+	}
+	sort.Ints(indexes)
+	validators := make(map[int][]fieldValidator, len(indexes))
+	for _, index := range indexes {
+		f := s.t.Field(index)
+		fieldValidators, err := s.val.parseRules(s.t, index, f.Type, s.rules[index])
+		if err != nil {
+			return &schemaState{err: errors.Wrap(err, f.Name)}
+		}
+		validators[index] = fieldValidators
+	}
+	return &schemaState{validators: validators}
+}
+
+func (s *Schema[T]) Validate(v T) error {
+	st := s.compiled()
+	if st.err != nil {
+		return st.err
+	}
+	return s.val.validateRoot(context.Background(), addressable(reflect.ValueOf(v)), nil, st.validators)
+}
@@ -0,0 +1,208 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type schemaUser struct {
+	Email    string
+	Age      int
+	Role     string
+	Tags     []string
+	Password string
+	Confirm  string
+	Nickname string
+}
+
+type taggedSchemaUser struct {
+	Email    string   `validate:"min:3;max:254"`
+	Age      int      `validate:"min:18"`
+	Role     string   `validate:"in:admin,user\\,guest"`
+	Tags     []string `validate:"max:2;dive;min:2"`
+	Password string   `validate:"eqfield:Confirm"`
+	Confirm  string
+	Nickname string `validate:"omitempty;min:3"`
+}
+
+func TestSchema(t *testing.T) {
+	schema := NewSchema[schemaUser]().
+		Field("Email", Min(3), Max(254)).
+		Field("Age", Min(18)).
+		Field("Role", In("admin", "user,guest")).
+		Field("Tags", Max(2), Dive(), Min(2)).
+		Field("Password", Rule{Name: "eqfield", Param: "Confirm"}).
+		Field("Nickname", OmitEmpty(), Min(3))
+	assert.NoError(t, schema.Compile())
+
+	tests := []struct {
+		name string
+		user schemaUser
+	}{
+		{
+			name: "valid",
+			user: schemaUser{Email: "a@b.co", Age: 30, Role: "user,guest", Tags: []string{"go"}, Password: "x", Confirm: "x"},
+		},
+		{
+			name: "invalid",
+			user: schemaUser{Email: "ab", Age: 7, Role: "root", Tags: []string{"go", "a", "rust"}, Password: "x", Confirm: "y", Nickname: "Al"},
+		},
+		{
+			name: "omitempty",
+			user: schemaUser{Email: "a@b.co", Age: 18, Role: "admin", Password: "x", Confirm: "x"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := tt.user
+			want := Validate(taggedSchemaUser{u.Email, u.Age, u.Role, u.Tags, u.Password, u.Confirm, u.Nickname})
+			got := schema.Validate(u)
+			if want == nil {
+				assert.NoError(t, got)
+				return
+			}
+			assert.Equal(t, want, got)
+		})
+	}
+
+	errs := schema.Validate(schemaUser{Email: "ab", Age: 18, Role: "admin", Password: "x", Confirm: "x"}).(ValidationErrors)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "Email", errs[0].Field)
+	assert.Equal(t, "min", errs[0].Rule)
+}
+
+func TestSchemaCompileErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema interface{ Compile() error }
+		target error
+	}{
+		{
+			name:   "unknown field",
+			schema: NewSchema[schemaUser]().Field("Emial", Min(3)),
+			target: ErrUnknownField,
+		},
+		{
+			name:   "rule kind mismatch",
+			schema: NewSchema[schemaUser]().Field("Age", Regexp("^[0-9]+$")),
+			target: ErrInvalidValidatorSyntax,
+		},
+		{
+			name:   "bad parameter",
+			schema: NewSchema[schemaUser]().Field("Age", Min("eighteen")),
+			target: ErrInvalidValidatorSyntax,
+		},
+		{
+			name:   "unexported field",
+			schema: NewSchema[struct{ name string }]().Field("name", Required()),
+			target: ErrValidateForUnexportedFields,
+		},
+		{
+			name:   "not a struct",
+			schema: NewSchema[string]().Field("Len", Min(1)),
+			target: ErrNotStruct,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.schema.Compile()
+			assert.True(t, errors.Is(err, tt.target), "unexpected error %v", err)
+		})
+	}
+
+	schema := NewSchema[schemaUser]().Field("Age", Min("x"))
+	assert.True(t, errors.Is(schema.Validate(schemaUser{}), ErrInvalidValidatorSyntax))
+}
+
+type schemaInner struct {
+	X string `validate:"required"`
+}
+
+type schemaOuter struct {
+	In    schemaInner
+	List  []schemaInner
+	Items map[string]*schemaInner
+	Code  string
+}
+
+type taggedSchemaOuter struct {
+	In    schemaInner
+	List  []schemaInner `validate:"minitems:1"`
+	Items map[string]*schemaInner
+	Code  string `validate:"len:3"`
+}
+
+var errSchemaReserved = errors.New("is reserved")
+
+func (o taggedSchemaOuter) ValidateStruct() error {
+	if o.Code == "bad" {
+		return ValidationError{Field: "Code", Err: errSchemaReserved}
+	}
+	return nil
+}
+
+func (o schemaOuter) ValidateStruct() error {
+	return taggedSchemaOuter{Code: o.Code}.ValidateStruct()
+}
+
+func TestSchemaNested(t *testing.T) {
+	schema := NewSchema[schemaOuter]().
+		Field("List", Rule{Name: "minitems", Param: "1"}).
+		Field("Code", Len(3))
+
+	tests := []struct {
+		name  string
+		outer schemaOuter
+	}{
+		{
+			name:  "valid",
+			outer: schemaOuter{In: schemaInner{"a"}, List: []schemaInner{{"b"}}, Code: "abc"},
+		},
+		{
+			name:  "nested slice and map",
+			outer: schemaOuter{List: []schemaInner{{}}, Items: map[string]*schemaInner{"b": {}, "a": {"x"}}, Code: "abc"},
+		},
+		{
+			name:  "field rule and nested",
+			outer: schemaOuter{In: schemaInner{"a"}, Code: "abcd"},
+		},
+		{
+			name:  "struct hook",
+			outer: schemaOuter{In: schemaInner{"a"}, List: []schemaInner{{"b"}}, Code: "bad"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := tt.outer
+			want := Validate(taggedSchemaOuter{o.In, o.List, o.Items, o.Code})
+			got := schema.Validate(o)
+			if want == nil {
+				assert.NoError(t, got)
+				return
+			}
+			assert.EqualError(t, got, want.Error())
+			wantErrs, gotErrs := want.(ValidationErrors), got.(ValidationErrors)
+			require.Len(t, gotErrs, len(wantErrs))
+			for i := range wantErrs {
+				assert.Equal(t, wantErrs[i].Field, gotErrs[i].Field)
+				assert.Equal(t, wantErrs[i].Rule, gotErrs[i].Rule)
+			}
+		})
+	}
+
+	errs := schema.Validate(schemaOuter{List: []schemaInner{{}}, Code: "abc"}).(ValidationErrors)
+	assert.Len(t, errs, 2)
+	assert.Equal(t, "In.X", errs[0].Field)
+	assert.Equal(t, "List[0].X", errs[1].Field)
+}
+
+func TestSchemaFrozen(t *testing.T) {
+	schema := NewSchema[schemaUser]().Field("Age", Min(18))
+	assert.Error(t, schema.Validate(schemaUser{Age: 1}))
+	schema.Field("Email", Min(3))
+	assert.True(t, errors.Is(schema.Compile(), ErrSchemaCompiled))
+	assert.True(t, errors.Is(schema.Validate(schemaUser{Age: 18}), ErrSchemaCompiled))
+}
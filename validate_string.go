@@ -0,0 +1,1167 @@
+package validator
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"github.com/pkg/errors"
+	"math"
+	"net/netip"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+func bytesOf(v reflect.Value) []byte {
+	if v.Kind() == reflect.String {
+		return []byte(v.String())
+	}
+	return v.Bytes()
+}
+
+type jsonValidator struct {
+	top string
+}
+
+func (v jsonValidator) validate(s reflect.Value) error {
+	data := bytesOf(s)
+	if !json.Valid(data) {
+		return errors.New("is not valid json")
+	}
+	data = bytes.TrimLeft(data, " \t\r\n")
+	switch {
+	case v.top == "object" && data[0] != '{':
+		return errors.New("is not a json object")
+	case v.top == "array" && data[0] != '[':
+		return errors.New("is not a json array")
+	}
+	return nil
+}
+
+func newJSONValidator(s string) (fieldValidator, error) {
+	switch s {
+	case "", "object", "array":
+		return jsonValidator{s}, nil
+	}
+	return nil, fmt.Errorf("unknown json type %s", s)
+}
+
+type regexpValidator struct {
+	re *regexp.Regexp
+}
+
+func (v regexpValidator) validate(s reflect.Value) error {
+	val := s.String()
+	if !v.re.MatchString(val) {
+		return fmt.Errorf("%s does not match %s", val, v.re)
+	}
+	return nil
+}
+
+func newRegexpValidator(s string) (fieldValidator, error) {
+	re, err := regexp.Compile(s)
+	if err != nil {
+		return nil, err
+	}
+	return regexpValidator{re}, nil
+}
+
+type urlValidator struct {
+	http bool
+}
+
+func (v urlValidator) validate(s reflect.Value) error {
+	val := s.String()
+	u, err := url.Parse(val)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return fmt.Errorf("%s is not an absolute url", val)
+	}
+	if v.http && u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("%s is not an http or https url", val)
+	}
+	return nil
+}
+
+func newURLValidator(s string) (fieldValidator, error) {
+	switch s {
+	case "":
+		return urlValidator{}, nil
+	case "http":
+		return urlValidator{http: true}, nil
+	}
+	return nil, fmt.Errorf("unknown url parameter %s", s)
+}
+
+type uuidValidator struct {
+	version byte
+}
+
+func (v uuidValidator) validate(s reflect.Value) error {
+	val := s.String()
+	if !isUUID(val) {
+		return fmt.Errorf("%s is not a valid uuid", val)
+	}
+	if v.version != 0 && val[14] != v.version {
+		return fmt.Errorf("%s is not a valid uuid version %c", val, v.version)
+	}
+	return nil
+}
+
+func isUUID(s string) bool {
+	if len(s) != 36 {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		switch i {
+		case 8, 13, 18, 23:
+			if s[i] != '-' {
+				return false
+			}
+		default:
+			if !isHexDigit(s[i]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func isHexDigit(c byte) bool {
+	return '0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F'
+}
+
+func newUUIDValidator(s string) (fieldValidator, error) {
+	switch {
+	case s == "" || s == "any":
+		return uuidValidator{}, nil
+	case len(s) == 1 && '1' <= s[0] && s[0] <= '8':
+		return uuidValidator{s[0]}, nil
+	}
+	return nil, fmt.Errorf("unknown uuid version %s", s)
+}
+
+type ipValidator struct {
+	family string
+}
+
+func (v ipValidator) validate(s reflect.Value) error {
+	val := s.String()
+	addr, err := netip.ParseAddr(val)
+	switch {
+	case err != nil:
+		return fmt.Errorf("%s is not a valid %s address", val, v.family)
+	case v.family == "ipv4" && !addr.Is4():
+		return fmt.Errorf("%s is not a valid ipv4 address", val)
+	case v.family == "ipv6" && !addr.Is6():
+		return fmt.Errorf("%s is not a valid ipv6 address", val)
+	}
+	return nil
+}
+
+func newIPValidator(s string) (fieldValidator, error) {
+	if len(s) != 0 {
+		return nil, ErrInvalidValidatorSyntax
+	}
+	return ipValidator{"ip"}, nil
+}
+
+func newIPv4Validator(s string) (fieldValidator, error) {
+	if len(s) != 0 {
+		return nil, ErrInvalidValidatorSyntax
+	}
+	return ipValidator{"ipv4"}, nil
+}
+
+func newIPv6Validator(s string) (fieldValidator, error) {
+	if len(s) != 0 {
+		return nil, ErrInvalidValidatorSyntax
+	}
+	return ipValidator{"ipv6"}, nil
+}
+
+func isHostnameLabel(l string) bool {
+	if len(l) == 0 || len(l) > 63 || l[0] == '-' || l[len(l)-1] == '-' {
+		return false
+	}
+	for i := 0; i < len(l); i++ {
+		c := l[i]
+		if !('a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' || c == '-') {
+			return false
+		}
+	}
+	return true
+}
+
+func hostnameLabels(s string) ([]string, bool) {
+	if len(s) == 0 || len(s) > 253 {
+		return nil, false
+	}
+	labels := strings.Split(s, ".")
+	for _, l := range labels {
+		if !isHostnameLabel(l) {
+			return nil, false
+		}
+	}
+	return labels, true
+}
+
+type hostnameValidator struct{}
+
+func (v hostnameValidator) validate(s reflect.Value) error {
+	val := s.String()
+	if _, ok := hostnameLabels(val); !ok {
+		return fmt.Errorf("%s is not a valid hostname", val)
+	}
+	return nil
+}
+
+func newHostnameValidator(s string) (fieldValidator, error) {
+	if len(s) != 0 {
+		return nil, ErrInvalidValidatorSyntax
+	}
+	return hostnameValidator{}, nil
+}
+
+type fqdnValidator struct{}
+
+func (v fqdnValidator) validate(s reflect.Value) error {
+	val := s.String()
+	labels, ok := hostnameLabels(strings.TrimSuffix(val, "."))
+	if !ok {
+		return fmt.Errorf("%s is not a valid fqdn", val)
+	}
+	if len(labels) < 2 {
+		return fmt.Errorf("%s is not a fully qualified domain name", val)
+	}
+	if strings.IndexFunc(labels[len(labels)-1], func(r rune) bool { return !isASCIIDigit(r) }) < 0 {
+		return fmt.Errorf("%s has numeric top-level domain", val)
+	}
+	return nil
+}
+
+func newFQDNValidator(s string) (fieldValidator, error) {
+	if len(s) != 0 {
+		return nil, ErrInvalidValidatorSyntax
+	}
+	return fqdnValidator{}, nil
+}
+
+type portValidator struct {
+	min, max uint64
+}
+
+func (v portValidator) validate(p reflect.Value) error {
+	var port uint64
+	ok := true
+	switch p.Kind() {
+	case reflect.String:
+		val, err := strconv.ParseUint(p.String(), 10, 16)
+		port, ok = val, err == nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		port = p.Uint()
+	default:
+		port, ok = uint64(p.Int()), p.Int() >= 0
+	}
+	if !ok || port < v.min || port > v.max {
+		return fmt.Errorf("%v is not a valid port in range %d-%d", p, v.min, v.max)
+	}
+	return nil
+}
+
+func newPortValidator(s string) (fieldValidator, error) {
+	switch s {
+	case "":
+		return portValidator{1, 65535}, nil
+	case "system":
+		return portValidator{1, 1023}, nil
+	case "user":
+		return portValidator{1024, 65535}, nil
+	}
+	return nil, fmt.Errorf("unknown port range %s", s)
+}
+
+type runeClass struct {
+	name    string
+	unicode func(rune) bool
+	ascii   func(rune) bool
+}
+
+var (
+	letters = runeClass{
+		name:    "letters",
+		unicode: unicode.IsLetter,
+		ascii:   isASCIILetter,
+	}
+	lettersAndDigits = runeClass{
+		name: "letters and digits",
+		unicode: func(r rune) bool {
+			return unicode.IsLetter(r) || unicode.IsDigit(r)
+		},
+		ascii: func(r rune) bool {
+			return isASCIILetter(r) || isASCIIDigit(r)
+		},
+	}
+)
+
+func isASCIILetter(r rune) bool {
+	return 'a' <= r && r <= 'z' || 'A' <= r && r <= 'Z'
+}
+
+func isASCIIDigit(r rune) bool {
+	return '0' <= r && r <= '9'
+}
+
+type runeClassValidator struct {
+	class runeClass
+	ascii bool
+}
+
+func (v runeClassValidator) validate(s reflect.Value) error {
+	val := s.String()
+	allowed, name := v.class.unicode, v.class.name
+	if v.ascii {
+		allowed, name = v.class.ascii, "ascii "+name
+	}
+	if len(val) == 0 || strings.IndexFunc(val, func(r rune) bool { return !allowed(r) }) >= 0 {
+		return fmt.Errorf("%s contains not only %s", val, name)
+	}
+	return nil
+}
+
+func newRuneClassValidator(class runeClass, s string) (fieldValidator, error) {
+	switch s {
+	case "":
+		return runeClassValidator{class: class}, nil
+	case "ascii":
+		return runeClassValidator{class: class, ascii: true}, nil
+	}
+	return nil, fmt.Errorf("unknown %s parameter %s", class.name, s)
+}
+
+func newAlphaValidator(s string) (fieldValidator, error) {
+	return newRuneClassValidator(letters, s)
+}
+
+func newAlphaNumValidator(s string) (fieldValidator, error) {
+	return newRuneClassValidator(lettersAndDigits, s)
+}
+
+var numericPatterns = map[string]*regexp.Regexp{
+	"":         regexp.MustCompile(`^[+-]?(\d+\.?\d*|\.\d+)([eE][+-]?\d+)?$`),
+	"int":      regexp.MustCompile(`^[+-]?\d+$`),
+	"unsigned": regexp.MustCompile(`^\d+$`),
+}
+
+type numericValidator struct {
+	kind string
+	re   *regexp.Regexp
+}
+
+func (v numericValidator) validate(s reflect.Value) error {
+	val := s.String()
+	if !v.re.MatchString(val) {
+		if v.kind == "" {
+			return fmt.Errorf("%s is not a number", val)
+		}
+		return fmt.Errorf("%s is not an %s number", val, v.kind)
+	}
+	return nil
+}
+
+func newNumericValidator(s string) (fieldValidator, error) {
+	re, ok := numericPatterns[s]
+	if !ok {
+		return nil, fmt.Errorf("unknown numeric kind %s", s)
+	}
+	return numericValidator{s, re}, nil
+}
+
+type lowercaseValidator struct{}
+
+func (v lowercaseValidator) validate(s reflect.Value) error {
+	val := s.String()
+	if val != strings.ToLower(val) {
+		return fmt.Errorf("%s is not lowercase", val)
+	}
+	return nil
+}
+
+func newLowercaseValidator(s string) (fieldValidator, error) {
+	if len(s) != 0 {
+		return nil, ErrInvalidValidatorSyntax
+	}
+	return lowercaseValidator{}, nil
+}
+
+type uppercaseValidator struct{}
+
+func (v uppercaseValidator) validate(s reflect.Value) error {
+	val := s.String()
+	if val != strings.ToUpper(val) {
+		return fmt.Errorf("%s is not uppercase", val)
+	}
+	return nil
+}
+
+func newUppercaseValidator(s string) (fieldValidator, error) {
+	if len(s) != 0 {
+		return nil, ErrInvalidValidatorSyntax
+	}
+	return uppercaseValidator{}, nil
+}
+
+type asciiValidator struct{}
+
+func (v asciiValidator) validate(s reflect.Value) error {
+	val := s.String()
+	for i, r := range val {
+		if r > unicode.MaxASCII {
+			return fmt.Errorf("%s contains non-ascii character %U at index %d", val, r, i)
+		}
+	}
+	return nil
+}
+
+func newASCIIValidator(s string) (fieldValidator, error) {
+	if len(s) != 0 {
+		return nil, ErrInvalidValidatorSyntax
+	}
+	return asciiValidator{}, nil
+}
+
+type printableValidator struct {
+	multiline bool
+}
+
+func (v printableValidator) validate(s reflect.Value) error {
+	val := s.String()
+	for i, r := range val {
+		if v.multiline && (r == '\n' || r == '\t') {
+			continue
+		}
+		if unicode.IsControl(r) {
+			return fmt.Errorf("%q contains control character %U at index %d", val, r, i)
+		}
+	}
+	return nil
+}
+
+func newPrintableValidator(s string) (fieldValidator, error) {
+	switch s {
+	case "":
+		return printableValidator{}, nil
+	case "multiline":
+		return printableValidator{multiline: true}, nil
+	}
+	return nil, fmt.Errorf("unknown printable parameter %s", s)
+}
+
+type containsValidator struct {
+	substr string
+	ci     bool
+}
+
+func (v containsValidator) validate(s reflect.Value) error {
+	val := s.String()
+	if v.ci && !strings.Contains(strings.ToLower(val), strings.ToLower(v.substr)) {
+		return fmt.Errorf("%s does not contain %q ignoring case", val, v.substr)
+	}
+	if !v.ci && !strings.Contains(val, v.substr) {
+		return fmt.Errorf("%s does not contain %q", val, v.substr)
+	}
+	return nil
+}
+
+func newContainsValidator(s string) (fieldValidator, error) {
+	if len(s) == 0 {
+		return nil, ErrInvalidValidatorSyntax
+	}
+	return containsValidator{substr: s}, nil
+}
+
+func newContainsCIValidator(s string) (fieldValidator, error) {
+	if len(s) == 0 {
+		return nil, ErrInvalidValidatorSyntax
+	}
+	return containsValidator{substr: s, ci: true}, nil
+}
+
+type prefixValidator struct {
+	prefixes []string
+}
+
+func (v prefixValidator) validate(s reflect.Value) error {
+	val := s.String()
+	for _, prefix := range v.prefixes {
+		if strings.HasPrefix(val, prefix) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%s does not start with any of %q", val, v.prefixes)
+}
+
+func newPrefixValidator(s string) (fieldValidator, error) {
+	prefixes, err := parseAlternatives(s)
+	if err != nil {
+		return nil, err
+	}
+	return prefixValidator{prefixes}, nil
+}
+
+func parseAlternatives(s string) ([]string, error) {
+	alternatives := parseStrSlice(s)
+	if contains(alternatives, "") {
+		return nil, ErrInvalidValidatorSyntax
+	}
+	return alternatives, nil
+}
+
+type suffixValidator struct {
+	suffixes []string
+}
+
+func (v suffixValidator) validate(s reflect.Value) error {
+	val := s.String()
+	for _, suffix := range v.suffixes {
+		if strings.HasSuffix(val, suffix) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%s does not end with any of %q", val, v.suffixes)
+}
+
+func newSuffixValidator(s string) (fieldValidator, error) {
+	suffixes, err := parseAlternatives(s)
+	if err != nil {
+		return nil, err
+	}
+	return suffixValidator{suffixes}, nil
+}
+
+var base64Encodings = map[string]struct {
+	enc  *base64.Encoding
+	desc string
+}{
+	"":       {base64.StdEncoding, "standard alphabet with padding"},
+	"raw":    {base64.RawStdEncoding, "standard alphabet without padding"},
+	"url":    {base64.URLEncoding, "url-safe alphabet with padding"},
+	"rawurl": {base64.RawURLEncoding, "url-safe alphabet without padding"},
+}
+
+type base64Validator struct {
+	enc  *base64.Encoding
+	desc string
+}
+
+func (v base64Validator) validate(s reflect.Value) error {
+	data := bytesOf(s)
+	if _, err := v.enc.DecodeString(string(data)); err != nil || len(data) == 0 {
+		return fmt.Errorf("is not valid base64, expected %s", v.desc)
+	}
+	return nil
+}
+
+func newBase64Validator(s string) (fieldValidator, error) {
+	encoding, ok := base64Encodings[s]
+	if !ok {
+		return nil, fmt.Errorf("unknown base64 encoding %s", s)
+	}
+	return base64Validator{encoding.enc, encoding.desc}, nil
+}
+
+type hexValidator struct {
+	l        int
+	prefixed bool
+}
+
+func (v hexValidator) validate(s reflect.Value) error {
+	data := bytesOf(s)
+	if v.prefixed && len(data) >= 2 && data[0] == '0' && data[1]|0x20 == 'x' {
+		data = data[2:]
+	}
+	if len(data) == 0 || len(data)%2 != 0 {
+		return errors.New("is not valid hex")
+	}
+	for _, c := range data {
+		if !isHexDigit(c) {
+			return errors.New("is not valid hex")
+		}
+	}
+	if v.l != 0 && len(data) != v.l {
+		return fmt.Errorf("hex length %d is not equal to %d", len(data), v.l)
+	}
+	return nil
+}
+
+func newHexValidator(s string) (fieldValidator, error) {
+	if s == "" {
+		return hexValidator{}, nil
+	}
+	var v hexValidator
+	if prefix, l, found := strings.Cut(s, ","); prefix == "0x" {
+		if !found {
+			return hexValidator{prefixed: true}, nil
+		}
+		v.prefixed, s = true, l
+	}
+	l, err := strconv.Atoi(s)
+	if err != nil || l <= 0 {
+		return nil, ErrInvalidValidatorSyntax
+	}
+	v.l = l
+	return v, nil
+}
+
+var datetimeLayouts = map[string]string{
+	"rfc3339":     time.RFC3339,
+	"rfc3339nano": time.RFC3339Nano,
+}
+
+type datetimeValidator struct {
+	layout string
+}
+
+func (v datetimeValidator) validate(s reflect.Value) error {
+	if _, err := time.Parse(v.layout, s.String()); err != nil {
+		return fmt.Errorf("%q does not match datetime layout %q", s.String(), v.layout)
+	}
+	return nil
+}
+
+func newDatetimeValidator(s string) (fieldValidator, error) {
+	if s == "" {
+		return nil, ErrInvalidValidatorSyntax
+	}
+	if layout, ok := datetimeLayouts[s]; ok {
+		s = layout
+	}
+	return datetimeValidator{layout: s}, nil
+}
+
+var semverPattern = regexp.MustCompile(`^(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)` +
+	`(-((0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)(\.(0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?` +
+	`(\+([0-9a-zA-Z-]+(\.[0-9a-zA-Z-]+)*))?$`)
+
+type semverValidator struct {
+	strict bool
+}
+
+func (v semverValidator) validate(s reflect.Value) error {
+	val := s.String()
+	if !v.strict {
+		val = strings.TrimPrefix(val, "v")
+	}
+	if !semverPattern.MatchString(val) {
+		return errors.New("is not a valid semantic version")
+	}
+	return nil
+}
+
+func newSemverValidator(s string) (fieldValidator, error) {
+	switch s {
+	case "":
+		return semverValidator{}, nil
+	case "strict":
+		return semverValidator{strict: true}, nil
+	}
+	return nil, ErrInvalidValidatorSyntax
+}
+
+var (
+	e164Pattern        = regexp.MustCompile(`^\+[1-9]\d{7,14}$`)
+	callingCodePattern = regexp.MustCompile(`^\+[1-9]\d{0,2}$`)
+)
+
+type e164Validator struct {
+	prefix string
+}
+
+func (v e164Validator) validate(s reflect.Value) error {
+	val := s.String()
+	if !e164Pattern.MatchString(val) {
+		return errors.New("is not an E.164 phone number, expected + followed by 8-15 digits")
+	}
+	if !strings.HasPrefix(val, v.prefix) {
+		return fmt.Errorf("phone number does not start with calling code %s", v.prefix)
+	}
+	return nil
+}
+
+func newE164Validator(s string) (fieldValidator, error) {
+	if s != "" && !callingCodePattern.MatchString(s) {
+		return nil, ErrInvalidValidatorSyntax
+	}
+	return e164Validator{prefix: s}, nil
+}
+
+type creditCardValidator struct{}
+
+func (v creditCardValidator) validate(s reflect.Value) error {
+	digits := strings.Map(func(r rune) rune {
+		if r == ' ' || r == '-' {
+			return -1
+		}
+		return r
+	}, s.String())
+	if len(digits) < 12 || len(digits) > 19 || strings.IndexFunc(digits, func(r rune) bool { return !isASCIIDigit(r) }) >= 0 {
+		return errors.New("is not a card number, expected 12-19 digits")
+	}
+	if !luhnValid(digits) {
+		return fmt.Errorf("card number %s has invalid checksum", maskCardNumber(digits))
+	}
+	return nil
+}
+
+func newCreditCardValidator(s string) (fieldValidator, error) {
+	if s != "" {
+		return nil, ErrInvalidValidatorSyntax
+	}
+	return creditCardValidator{}, nil
+}
+
+func luhnValid(digits string) bool {
+	sum := 0
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		if (len(digits)-i)%2 == 0 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	return sum%10 == 0
+}
+
+func maskCardNumber(digits string) string {
+	return strings.Repeat("*", len(digits)-4) + digits[len(digits)-4:]
+}
+
+type runeLenValidator struct {
+	l int
+}
+
+func (v runeLenValidator) validate(s reflect.Value) error {
+	if n := utf8.RuneCountInString(s.String()); n != v.l {
+		return fmt.Errorf("len of %d runes is not equal to %d", n, v.l)
+	}
+	return nil
+}
+
+func newRuneLenValidator(s string) (fieldValidator, error) {
+	val, err := strconv.Atoi(s)
+	if err != nil {
+		return nil, err
+	}
+	return runeLenValidator{val}, nil
+}
+
+type runeMinValidator struct {
+	min int
+}
+
+func (v runeMinValidator) validate(s reflect.Value) error {
+	if n := utf8.RuneCountInString(s.String()); n < v.min {
+		return fmt.Errorf("len of %d runes is less than min allowed %d", n, v.min)
+	}
+	return nil
+}
+
+func newRuneMinValidator(s string) (fieldValidator, error) {
+	val, err := strconv.Atoi(s)
+	if err != nil {
+		return nil, err
+	}
+	return runeMinValidator{val}, nil
+}
+
+type runeMaxValidator struct {
+	max int
+}
+
+func (v runeMaxValidator) validate(s reflect.Value) error {
+	if n := utf8.RuneCountInString(s.String()); n > v.max {
+		return fmt.Errorf("len of %d runes is higher than max allowed %d", n, v.max)
+	}
+	return nil
+}
+
+func newRuneMaxValidator(s string) (fieldValidator, error) {
+	val, err := strconv.Atoi(s)
+	if err != nil {
+		return nil, err
+	}
+	return runeMaxValidator{val}, nil
+}
+
+type noWhitespaceValidator struct{}
+
+func (v noWhitespaceValidator) validate(s reflect.Value) error {
+	val := s.String()
+	if i := strings.IndexFunc(val, unicode.IsSpace); i >= 0 {
+		r, _ := utf8.DecodeRuneInString(val[i:])
+		return fmt.Errorf("%q contains whitespace %U at index %d", val, r, i)
+	}
+	return nil
+}
+
+func newNoWhitespaceValidator(s string) (fieldValidator, error) {
+	if len(s) != 0 {
+		return nil, ErrInvalidValidatorSyntax
+	}
+	return noWhitespaceValidator{}, nil
+}
+
+type singleLineValidator struct{}
+
+func (v singleLineValidator) validate(s reflect.Value) error {
+	val := s.String()
+	if i := strings.IndexAny(val, "\r\n"); i >= 0 {
+		return fmt.Errorf("%q contains line break at index %d", val, i)
+	}
+	return nil
+}
+
+func newSingleLineValidator(s string) (fieldValidator, error) {
+	if len(s) != 0 {
+		return nil, ErrInvalidValidatorSyntax
+	}
+	return singleLineValidator{}, nil
+}
+
+var slugPatterns = map[string]*regexp.Regexp{
+	"":           regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`),
+	"underscore": regexp.MustCompile(`^[a-z0-9]+([-_][a-z0-9]+)*$`),
+}
+
+type slugValidator struct {
+	re *regexp.Regexp
+}
+
+func (v slugValidator) validate(s reflect.Value) error {
+	if !v.re.MatchString(s.String()) {
+		return fmt.Errorf("%q is not a valid slug", s.String())
+	}
+	return nil
+}
+
+func newSlugValidator(s string) (fieldValidator, error) {
+	re, ok := slugPatterns[s]
+	if !ok {
+		return nil, ErrInvalidValidatorSyntax
+	}
+	return slugValidator{re}, nil
+}
+
+type isbnValidator struct {
+	flavor string
+}
+
+func (v isbnValidator) validate(s reflect.Value) error {
+	val := strings.Map(func(r rune) rune {
+		if r == ' ' || r == '-' {
+			return -1
+		}
+		return r
+	}, s.String())
+	switch {
+	case v.flavor != "13" && isISBN10(val), v.flavor != "10" && isISBN13(val):
+		return nil
+	case v.flavor == "":
+		return fmt.Errorf("%s is not a valid ISBN", s.String())
+	}
+	return fmt.Errorf("%s is not a valid ISBN-%s", s.String(), v.flavor)
+}
+
+func newISBNValidator(s string) (fieldValidator, error) {
+	switch s {
+	case "", "10", "13":
+		return isbnValidator{s}, nil
+	}
+	return nil, ErrInvalidValidatorSyntax
+}
+
+func isISBN10(s string) bool {
+	if len(s) != 10 {
+		return false
+	}
+	sum := 0
+	for i := 0; i < 10; i++ {
+		var d int
+		switch c := s[i]; {
+		case isASCIIDigit(rune(c)):
+			d = int(c - '0')
+		case i == 9 && (c == 'X' || c == 'x'):
+			d = 10
+		default:
+			return false
+		}
+		sum += (10 - i) * d
+	}
+	return sum%11 == 0
+}
+
+func isISBN13(s string) bool {
+	if len(s) != 13 {
+		return false
+	}
+	sum := 0
+	for i := 0; i < 13; i++ {
+		if !isASCIIDigit(rune(s[i])) {
+			return false
+		}
+		d := int(s[i] - '0')
+		if i%2 == 1 {
+			d *= 3
+		}
+		sum += d
+	}
+	return sum%10 == 0
+}
+
+var countryAlpha3Codes = func() map[string]string {
+	codes := make(map[string]string, len(countryCodes))
+	for alpha2, alpha3 := range countryCodes {
+		codes[alpha3] = alpha2
+	}
+	return codes
+}()
+
+type countryCodeValidator struct {
+	flavor string
+	codes  map[string]string
+	other  map[string]string
+}
+
+func (v countryCodeValidator) validate(s reflect.Value) error {
+	code := strings.ToUpper(s.String())
+	if _, ok := v.codes[code]; ok {
+		return nil
+	}
+	if canonical, ok := v.other[code]; ok {
+		return fmt.Errorf("%s is not an ISO 3166-1 %s country code, did you mean %s", code, v.flavor, canonical)
+	}
+	return fmt.Errorf("%s is not an ISO 3166-1 %s country code", code, v.flavor)
+}
+
+func newCountryCodeValidator(s string) (fieldValidator, error) {
+	switch s {
+	case "", "alpha2":
+		return countryCodeValidator{"alpha-2", countryCodes, countryAlpha3Codes}, nil
+	case "alpha3":
+		return countryCodeValidator{"alpha-3", countryAlpha3Codes, countryCodes}, nil
+	}
+	return nil, ErrInvalidValidatorSyntax
+}
+
+type currencyCodeValidator struct{}
+
+func (v currencyCodeValidator) validate(s reflect.Value) error {
+	code := strings.ToUpper(s.String())
+	if _, ok := currencyCodes[code]; !ok {
+		return fmt.Errorf("%s is not a known ISO 4217 currency code", code)
+	}
+	return nil
+}
+
+func newCurrencyCodeValidator(s string) (fieldValidator, error) {
+	if len(s) != 0 {
+		return nil, ErrInvalidValidatorSyntax
+	}
+	return currencyCodeValidator{}, nil
+}
+
+type coordinateValidator struct {
+	name  string
+	limit float64
+}
+
+func (v coordinateValidator) validate(c reflect.Value) error {
+	var val float64
+	if c.Kind() == reflect.String {
+		f, err := strconv.ParseFloat(c.String(), 64)
+		if err != nil {
+			return fmt.Errorf("%q is not a valid %s", c.String(), v.name)
+		}
+		val = f
+	} else {
+		val = c.Float()
+	}
+	if math.IsNaN(val) || math.IsInf(val, 0) || val < -v.limit || val > v.limit {
+		return fmt.Errorf("%v is not a valid %s in range %g..%g", c, v.name, -v.limit, v.limit)
+	}
+	return nil
+}
+
+func newLatitudeValidator(s string) (fieldValidator, error) {
+	if len(s) != 0 {
+		return nil, ErrInvalidValidatorSyntax
+	}
+	return coordinateValidator{"latitude", 90}, nil
+}
+
+func newLongitudeValidator(s string) (fieldValidator, error) {
+	if len(s) != 0 {
+		return nil, ErrInvalidValidatorSyntax
+	}
+	return coordinateValidator{"longitude", 180}, nil
+}
+
+type cidrValidator struct {
+	family string
+	strict bool
+}
+
+func (v cidrValidator) validate(s reflect.Value) error {
+	val := s.String()
+	prefix, err := netip.ParsePrefix(val)
+	switch {
+	case err != nil:
+		return fmt.Errorf("%s is not a valid %s", val, v.family)
+	case v.family == "ipv4 cidr" && !prefix.Addr().Is4():
+		return fmt.Errorf("%s is not a valid ipv4 cidr", val)
+	case v.family == "ipv6 cidr" && !prefix.Addr().Is6():
+		return fmt.Errorf("%s is not a valid ipv6 cidr", val)
+	case v.strict && prefix.Masked() != prefix:
+		return fmt.Errorf("%s has host bits set, expected %s", val, prefix.Masked())
+	}
+	return nil
+}
+
+func newCIDRValidator(s string) (fieldValidator, error) {
+	v := cidrValidator{family: "cidr"}
+	if len(s) == 0 {
+		return v, nil
+	}
+	for _, opt := range parseStrSlice(s) {
+		switch {
+		case opt == "strict" && !v.strict:
+			v.strict = true
+		case (opt == "ipv4" || opt == "ipv6") && v.family == "cidr":
+			v.family = opt + " cidr"
+		default:
+			return nil, ErrInvalidValidatorSyntax
+		}
+	}
+	return v, nil
+}
+
+type jwtValidator struct{}
+
+func (v jwtValidator) validate(s reflect.Value) error {
+	segments := strings.Split(s.String(), ".")
+	if len(segments) != 3 {
+		return fmt.Errorf("jwt must have 3 segments, got %d", len(segments))
+	}
+	names := [...]string{"header", "payload", "signature"}
+	var header []byte
+	for i, seg := range segments {
+		if len(seg) == 0 {
+			return fmt.Errorf("jwt %s segment is empty", names[i])
+		}
+		b, err := base64.RawURLEncoding.DecodeString(seg)
+		if err != nil {
+			return fmt.Errorf("jwt %s segment is not valid base64url", names[i])
+		}
+		if i == 0 {
+			header = b
+		}
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(header, &fields); err != nil {
+		return errors.New("jwt header is not a json object")
+	}
+	if _, ok := fields["alg"]; !ok {
+		return errors.New("jwt header has no alg field")
+	}
+	return nil
+}
+
+func newJWTValidator(s string) (fieldValidator, error) {
+	if len(s) != 0 {
+		return nil, ErrInvalidValidatorSyntax
+	}
+	return jwtValidator{}, nil
+}
+
+var hexColorForms = map[int]string{3: "#RGB", 6: "#RRGGBB", 8: "#RRGGBBAA"}
+
+type hexColorValidator struct {
+	lengths []int
+}
+
+func (v hexColorValidator) validate(s reflect.Value) error {
+	val := s.String()
+	digits := strings.TrimPrefix(val, "#")
+	ok := len(digits) < len(val) && contains(v.lengths, len(digits))
+	for i := 0; ok && i < len(digits); i++ {
+		ok = isHexDigit(digits[i])
+	}
+	if !ok {
+		forms := make([]string, 0, len(v.lengths))
+		for _, l := range v.lengths {
+			forms = append(forms, hexColorForms[l])
+		}
+		return fmt.Errorf("%s is not a valid hex color, expected %s", val, strings.Join(forms, " or "))
+	}
+	return nil
+}
+
+func newHexColorValidator(s string) (fieldValidator, error) {
+	if len(s) == 0 {
+		return hexColorValidator{[]int{3, 6, 8}}, nil
+	}
+	lengths, err := parseIntSlice(s)
+	if err != nil {
+		return nil, err
+	}
+	v := hexColorValidator{make([]int, 0, len(lengths))}
+	for _, l := range lengths {
+		if _, ok := hexColorForms[int(l)]; !ok {
+			return nil, ErrInvalidValidatorSyntax
+		}
+		v.lengths = append(v.lengths, int(l))
+	}
+	return v, nil
+}
+
+var colorFunctions = map[string]*regexp.Regexp{
+	"rgb":  regexp.MustCompile(`^rgb\(\s*\d{1,3}%?\s*,\s*\d{1,3}%?\s*,\s*\d{1,3}%?\s*\)$`),
+	"rgba": regexp.MustCompile(`^rgba\(\s*\d{1,3}%?\s*,\s*\d{1,3}%?\s*,\s*\d{1,3}%?\s*,\s*(0|1|0?\.\d+|\d{1,3}%)\s*\)$`),
+	"hsl":  regexp.MustCompile(`^hsl\(\s*\d{1,3}\s*,\s*\d{1,3}%\s*,\s*\d{1,3}%\s*\)$`),
+	"hsla": regexp.MustCompile(`^hsla\(\s*\d{1,3}\s*,\s*\d{1,3}%\s*,\s*\d{1,3}%\s*,\s*(0|1|0?\.\d+|\d{1,3}%)\s*\)$`),
+}
+
+type colorValidator struct {
+	hex hexColorValidator
+}
+
+func (v colorValidator) validate(s reflect.Value) error {
+	val := strings.ToLower(s.String())
+	if strings.HasPrefix(val, "#") {
+		return v.hex.validate(s)
+	}
+	name, _, ok := strings.Cut(val, "(")
+	re, known := colorFunctions[strings.TrimSpace(name)]
+	switch {
+	case !ok || !known:
+		return fmt.Errorf("%s is not a valid color, expected hex or rgb(), rgba(), hsl(), hsla()", s.String())
+	case !re.MatchString(val):
+		return fmt.Errorf("%s is not a valid %s() color", s.String(), strings.TrimSpace(name))
+	}
+	return nil
+}
+
+func newColorValidator(s string) (fieldValidator, error) {
+	if len(s) != 0 {
+		return nil, ErrInvalidValidatorSyntax
+	}
+	return colorValidator{hexColorValidator{[]int{3, 6, 8}}}, nil
+}
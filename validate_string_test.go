@@ -0,0 +1,1574 @@
+package validator
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+	_ "time/tzdata"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateStringRules(t *testing.T) {
+	type args struct {
+		v any
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantErr  bool
+		checkErr func(err error) bool
+	}{
+		{
+			name: "valid struct with url fields",
+			args: args{
+				v: struct {
+					Homepage string `validate:"url"`
+					Callback string `validate:"url:http"`
+					Database string `validate:"url"`
+				}{
+					Homepage: "https://example.com/path?q=1",
+					Callback: "http://localhost:8080/hook",
+					Database: "postgres://user:pass@db:5432/app",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong url fields",
+			args: args{
+				v: struct {
+					Relative string `validate:"url"`
+					Text     string `validate:"url"`
+					NoHost   string `validate:"url"`
+					Callback string `validate:"url:http"`
+					BadSpec  string `validate:"url:ftp"`
+				}{
+					Relative: "/path?q=1",
+					Text:     "not a url",
+					NoHost:   "mailto:user@example.com",
+					Callback: "ftp://example.com/file",
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 5)
+				assert.Equal(t, "Text: not a url is not an absolute url", errs[1].Error())
+				assert.Equal(t, "Callback: ftp://example.com/file is not an http or https url", errs[3].Error())
+				assert.ErrorIs(t, errs[4].Err, ErrInvalidValidatorSyntax)
+				return true
+			},
+		},
+		{
+			name: "valid struct with uuid fields",
+			args: args{
+				v: struct {
+					ID    string `validate:"uuid"`
+					Upper string `validate:"uuid:any"`
+					V4    string `validate:"uuid:4"`
+				}{
+					ID:    "123e4567-e89b-12d3-a456-426614174000",
+					Upper: "123E4567-E89B-12D3-A456-426614174000",
+					V4:    "9b2a6c1e-3f4d-4a8b-9c0d-1e2f3a4b5c6d",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong uuid fields",
+			args: args{
+				v: struct {
+					Braces  string `validate:"uuid"`
+					URN     string `validate:"uuid"`
+					NoDash  string `validate:"uuid"`
+					NotHex  string `validate:"uuid"`
+					V4      string `validate:"uuid:4"`
+					BadSpec string `validate:"uuid:9"`
+				}{
+					Braces: "{123e4567-e89b-12d3-a456-426614174000}",
+					URN:    "urn:uuid:123e4567-e89b-12d3-a456-426614174000",
+					NoDash: "123e4567e89b12d3a456426614174000",
+					NotHex: "123e4567-e89b-12d3-a456-42661417400g",
+					V4:     "123e4567-e89b-12d3-a456-426614174000",
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 6)
+				assert.Equal(t, "V4: 123e4567-e89b-12d3-a456-426614174000 is not a valid uuid version 4", errs[4].Error())
+				assert.ErrorIs(t, errs[5].Err, ErrInvalidValidatorSyntax)
+				return true
+			},
+		},
+		{
+			name: "valid struct with ip fields",
+			args: args{
+				v: struct {
+					Any    string `validate:"ip"`
+					AnyV6  string `validate:"ip"`
+					V4     string `validate:"ipv4"`
+					V6     string `validate:"ipv6"`
+					Mapped string `validate:"ipv6"`
+				}{
+					Any:    "10.0.0.1",
+					AnyV6:  "2001:db8::1",
+					V4:     "192.168.1.1",
+					V6:     "::1",
+					Mapped: "::ffff:1.2.3.4",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong ip fields",
+			args: args{
+				v: struct {
+					Any     string `validate:"ip"`
+					V4      string `validate:"ipv4"`
+					Mapped  string `validate:"ipv4"`
+					Zeros   string `validate:"ipv4"`
+					V6      string `validate:"ipv6"`
+					BadSpec string `validate:"ip:4"`
+				}{
+					Any:    "localhost",
+					V4:     "2001:db8::1",
+					Mapped: "::ffff:1.2.3.4",
+					Zeros:  "01.2.3.4",
+					V6:     "10.0.0.1",
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 6)
+				assert.Equal(t, "Any: localhost is not a valid ip address", errs[0].Error())
+				assert.Equal(t, "Mapped: ::ffff:1.2.3.4 is not a valid ipv4 address", errs[2].Error())
+				assert.Equal(t, "V6: 10.0.0.1 is not a valid ipv6 address", errs[4].Error())
+				assert.ErrorIs(t, errs[5].Err, ErrInvalidValidatorSyntax)
+				return true
+			},
+		},
+		{
+			name: "valid struct with hostname fields",
+			args: args{
+				v: struct {
+					Bare     string `validate:"hostname"`
+					Dotted   string `validate:"hostname"`
+					Punycode string `validate:"hostname"`
+					Digits   string `validate:"hostname"`
+					MaxLabel string `validate:"hostname"`
+				}{
+					Bare:     "localhost",
+					Dotted:   "api.example.com",
+					Punycode: "xn--bcher-kva.example",
+					Digits:   "1-2-3.example",
+					MaxLabel: strings.Repeat("a", 63) + ".com",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong hostname fields",
+			args: args{
+				v: struct {
+					Empty       string `validate:"hostname"`
+					Underscore  string `validate:"hostname"`
+					Leading     string `validate:"hostname"`
+					Trailing    string `validate:"hostname"`
+					TrailingDot string `validate:"hostname"`
+					LongLabel   string `validate:"hostname"`
+					TooLong     string `validate:"hostname"`
+					Scheme      string `validate:"hostname"`
+					BadSpec     string `validate:"hostname:strict"`
+				}{
+					Underscore:  "my_host",
+					Leading:     "-host.example",
+					Trailing:    "host-.example",
+					TrailingDot: "example.com.",
+					LongLabel:   strings.Repeat("a", 64) + ".com",
+					TooLong:     strings.Repeat(strings.Repeat("a", 62)+".", 4) + "com",
+					Scheme:      "https://example.com",
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 9)
+				assert.Equal(t, "Underscore: my_host is not a valid hostname", errs[1].Error())
+				assert.ErrorIs(t, errs[8].Err, ErrInvalidValidatorSyntax)
+				return true
+			},
+		},
+		{
+			name: "valid struct with port fields",
+			args: args{
+				v: struct {
+					Port       int    `validate:"port"`
+					Uint       uint16 `validate:"port"`
+					String     string `validate:"port"`
+					System     int    `validate:"port:system"`
+					User       string `validate:"port:user"`
+					UserMax    uint32 `validate:"port:user"`
+					PortString string `validate:"port;len:4"`
+				}{
+					Port:       1,
+					Uint:       65535,
+					String:     "8080",
+					System:     22,
+					User:       "1024",
+					UserMax:    65535,
+					PortString: "9000",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong port fields",
+			args: args{
+				v: struct {
+					Zero     int     `validate:"port"`
+					Negative int     `validate:"port"`
+					TooHigh  uint32  `validate:"port"`
+					String   string  `validate:"port"`
+					NotInt   string  `validate:"port"`
+					System   int     `validate:"port:system"`
+					User     string  `validate:"port:user"`
+					BadSpec  int     `validate:"port:any"`
+					Float    float64 `validate:"port"`
+				}{
+					Negative: -80,
+					TooHigh:  65536,
+					String:   "0",
+					NotInt:   "http",
+					System:   8080,
+					User:     "80",
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 9)
+				assert.Equal(t, "System: 8080 is not a valid port in range 1-1023", errs[5].Error())
+				assert.ErrorIs(t, errs[7].Err, ErrInvalidValidatorSyntax)
+				assert.ErrorIs(t, errs[8].Err, ErrInvalidValidatorSyntax)
+				return true
+			},
+		},
+		{
+			name: "valid struct with alpha fields",
+			args: args{
+				v: struct {
+					Name     string `validate:"alpha"`
+					Accented string `validate:"alpha"`
+					Cyrillic string `validate:"alpha"`
+					ASCII    string `validate:"alpha:ascii"`
+				}{
+					Name:     "John",
+					Accented: "Zoë",
+					Cyrillic: "Артём",
+					ASCII:    "username",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong alpha fields",
+			args: args{
+				v: struct {
+					Empty    string `validate:"alpha"`
+					Digits   string `validate:"alpha"`
+					Space    string `validate:"alpha"`
+					Accented string `validate:"alpha:ascii"`
+					BadSpec  string `validate:"alpha:latin"`
+				}{
+					Digits:   "user1",
+					Space:    "John Doe",
+					Accented: "Zoë",
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 5)
+				assert.Equal(t, "Digits: user1 contains not only letters", errs[1].Error())
+				assert.Equal(t, "Accented: Zoë contains not only ascii letters", errs[3].Error())
+				assert.ErrorIs(t, errs[4].Err, ErrInvalidValidatorSyntax)
+				return true
+			},
+		},
+		{
+			name: "valid struct with alphanum fields",
+			args: args{
+				v: struct {
+					Identifier string `validate:"alphanum"`
+					Unicode    string `validate:"alphanum"`
+					PromoCode  string `validate:"alphanum:ascii"`
+				}{
+					Identifier: "user42",
+					Unicode:    "Артём2",
+					PromoCode:  "SUMMER2024",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong alphanum fields",
+			args: args{
+				v: struct {
+					Empty       string `validate:"alphanum"`
+					Space       string `validate:"alphanum"`
+					Punctuation string `validate:"alphanum"`
+					Unicode     string `validate:"alphanum:ascii"`
+					BadSpec     string `validate:"alphanum:latin"`
+				}{
+					Space:       "promo 2024",
+					Punctuation: "user-42",
+					Unicode:     "Zoë2",
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 5)
+				assert.Equal(t, "Punctuation: user-42 contains not only letters and digits", errs[2].Error())
+				assert.Equal(t, "Unicode: Zoë2 contains not only ascii letters and digits", errs[3].Error())
+				assert.ErrorIs(t, errs[4].Err, ErrInvalidValidatorSyntax)
+				return true
+			},
+		},
+		{
+			name: "valid struct with numeric fields",
+			args: args{
+				v: struct {
+					Int      string `validate:"numeric"`
+					Float    string `validate:"numeric"`
+					Exp      string `validate:"numeric"`
+					Signed   string `validate:"numeric:int"`
+					Unsigned string `validate:"numeric:unsigned"`
+				}{
+					Int:      "42",
+					Float:    "-3.5",
+					Exp:      "1e5",
+					Signed:   "-17",
+					Unsigned: "007",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong numeric fields",
+			args: args{
+				v: struct {
+					Empty    string `validate:"numeric"`
+					Spaces   string `validate:"numeric"`
+					NaN      string `validate:"numeric"`
+					Exp      string `validate:"numeric:int"`
+					Float    string `validate:"numeric:int"`
+					Negative string `validate:"numeric:unsigned"`
+					BadSpec  string `validate:"numeric:hex"`
+				}{
+					Spaces:   " 42 ",
+					NaN:      "NaN",
+					Exp:      "1e5",
+					Float:    "3.5",
+					Negative: "-1",
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 7)
+				assert.Equal(t, "NaN: NaN is not a number", errs[2].Error())
+				assert.Equal(t, "Negative: -1 is not an unsigned number", errs[5].Error())
+				assert.ErrorIs(t, errs[6].Err, ErrInvalidValidatorSyntax)
+				return true
+			},
+		},
+		{
+			name: "valid struct with case fields",
+			args: args{
+				v: struct {
+					Slug    string `validate:"lowercase"`
+					Country string `validate:"uppercase;len:2"`
+					Empty   string `validate:"lowercase;uppercase"`
+					Digits  string `validate:"lowercase;uppercase"`
+				}{
+					Slug:    "my-post_2024",
+					Country: "GB",
+					Digits:  "42-17.5",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong case fields",
+			args: args{
+				v: struct {
+					Slug    string `validate:"lowercase"`
+					Country string `validate:"uppercase"`
+					BadSpec string `validate:"lowercase:ascii"`
+				}{
+					Slug:    "My-Post",
+					Country: "Gb",
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 3)
+				assert.Equal(t, "Slug: My-Post is not lowercase", errs[0].Error())
+				assert.Equal(t, "Country: Gb is not uppercase", errs[1].Error())
+				assert.ErrorIs(t, errs[2].Err, ErrInvalidValidatorSyntax)
+				return true
+			},
+		},
+		{
+			name: "valid struct with ascii and printable fields",
+			args: args{
+				v: struct {
+					ASCII     string `validate:"ascii"`
+					Name      string `validate:"printable"`
+					Multiline string `validate:"printable:multiline"`
+					Empty     string `validate:"ascii;printable"`
+				}{
+					ASCII:     "Hello, World! ~",
+					Name:      "Zoë O'Brien",
+					Multiline: "line 1\n\tline 2",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong ascii and printable fields",
+			args: args{
+				v: struct {
+					ASCII     string `validate:"ascii"`
+					Name      string `validate:"printable"`
+					Newline   string `validate:"printable"`
+					Multiline string `validate:"printable:multiline"`
+					BadSpec   string `validate:"printable:all"`
+				}{
+					ASCII:     "Zoë",
+					Name:      "admin\x1b[31m",
+					Newline:   "John\nDoe",
+					Multiline: "line 1\r\nline 2",
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 5)
+				assert.Equal(t, "ASCII: Zoë contains non-ascii character U+00EB at index 2", errs[0].Error())
+				assert.Equal(t, `Name: "admin\x1b[31m" contains control character U+001B at index 5`, errs[1].Error())
+				assert.Equal(t, `Multiline: "line 1\r\nline 2" contains control character U+000D at index 6`, errs[3].Error())
+				assert.ErrorIs(t, errs[4].Err, ErrInvalidValidatorSyntax)
+				return true
+			},
+		},
+		{
+			name: "valid struct with contains fields",
+			args: args{
+				v: struct {
+					Email    string `validate:"contains:@"`
+					Address  string `validate:"contains::80"`
+					List     string `validate:"contains:a,b"`
+					Escaped  string `validate:"contains:a\\;b;min:3"`
+					Greeting string `validate:"contains_ci:HELLO"`
+				}{
+					Email:    "user@example.com",
+					Address:  "localhost:8080",
+					List:     "x,a,b,y",
+					Escaped:  "a;b",
+					Greeting: "well, hello there",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong contains fields",
+			args: args{
+				v: struct {
+					Email    string `validate:"contains:@"`
+					Greeting string `validate:"contains_ci:HELLO"`
+					Case     string `validate:"contains:Hello"`
+					Empty    string `validate:"contains"`
+				}{
+					Email:    "user.example.com",
+					Greeting: "hi there",
+					Case:     "hello",
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 4)
+				assert.Equal(t, `Email: user.example.com does not contain "@"`, errs[0].Error())
+				assert.Equal(t, `Greeting: hi there does not contain "HELLO" ignoring case`, errs[1].Error())
+				assert.ErrorIs(t, errs[3].Err, ErrInvalidValidatorSyntax)
+				return true
+			},
+		},
+		{
+			name: "valid struct with prefix fields",
+			args: args{
+				v: struct {
+					Phone    string `validate:"prefix:+7"`
+					URL      string `validate:"prefix:http://,https://"`
+					Resource string `validate:"startswith:projects/"`
+					Comma    string `validate:"prefix:a\\,b,c"`
+				}{
+					Phone:    "+79001234567",
+					URL:      "https://example.com",
+					Resource: "projects/42",
+					Comma:    "a,b,c",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong prefix fields",
+			args: args{
+				v: struct {
+					Phone    string `validate:"prefix:+7"`
+					URL      string `validate:"prefix:http://,https://"`
+					Comma    string `validate:"prefix:a\\,b"`
+					Empty    string `validate:"prefix"`
+					EmptyAlt string `validate:"prefix:a,"`
+				}{
+					Phone: "89001234567",
+					URL:   "ftp://example.com",
+					Comma: "a",
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 5)
+				assert.Equal(t, `URL: ftp://example.com does not start with any of ["http://" "https://"]`, errs[1].Error())
+				assert.Equal(t, `Comma: a does not start with any of ["a,b"]`, errs[2].Error())
+				assert.ErrorIs(t, errs[3].Err, ErrInvalidValidatorSyntax)
+				assert.ErrorIs(t, errs[4].Err, ErrInvalidValidatorSyntax)
+				return true
+			},
+		},
+		{
+			name: "valid struct with suffix fields",
+			args: args{
+				v: struct {
+					Host  string `validate:"suffix:.internal"`
+					File  string `validate:"suffix:.csv,.tsv"`
+					Name  string `validate:"endswith:_test"`
+					Comma string `validate:"suffix:a\\,b"`
+				}{
+					Host:  "db.prod.internal",
+					File:  "report.tsv",
+					Name:  "user_test",
+					Comma: "x,a,b",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong suffix fields",
+			args: args{
+				v: struct {
+					Host     string `validate:"suffix:.internal"`
+					File     string `validate:"suffix:.csv,.tsv"`
+					Empty    string `validate:"suffix"`
+					EmptyAlt string `validate:"suffix:,.csv"`
+				}{
+					Host: "db.prod.internal.example.com",
+					File: "report.xlsx",
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 4)
+				assert.Equal(t, `File: report.xlsx does not end with any of [".csv" ".tsv"]`, errs[1].Error())
+				assert.ErrorIs(t, errs[2].Err, ErrInvalidValidatorSyntax)
+				assert.ErrorIs(t, errs[3].Err, ErrInvalidValidatorSyntax)
+				return true
+			},
+		},
+		{
+			name: "valid struct with not_in fields",
+			args: args{
+				v: struct {
+					Username string `validate:"not_in:admin,root,system"`
+					Case     string `validate:"not_in:admin"`
+					CI       string `validate:"not_in_ci:admin,root"`
+					Comma    string `validate:"not_in:a\\,b"`
+				}{
+					Username: "alice",
+					Case:     "Admin",
+					CI:       "administrator",
+					Comma:    "a",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong not_in fields",
+			args: args{
+				v: struct {
+					Username string `validate:"not_in:admin,root,system"`
+					CI       string `validate:"not_in_ci:admin,root"`
+					Comma    string `validate:"not_in:a\\,b"`
+					Empty    string `validate:"not_in"`
+				}{
+					Username: "root",
+					CI:       "ROOT",
+					Comma:    "a,b",
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 4)
+				assert.Equal(t, "Username: root matches forbidden value root", errs[0].Error())
+				assert.Equal(t, "CI: ROOT matches forbidden value root", errs[1].Error())
+				assert.ErrorIs(t, errs[3].Err, ErrInvalidValidatorSyntax)
+				return true
+			},
+		},
+		{
+			name: "valid struct with base64 fields",
+			args: args{
+				v: struct {
+					Std    string `validate:"base64"`
+					Raw    string `validate:"base64:raw"`
+					URL    string `validate:"base64:url"`
+					RawURL string `validate:"base64:rawurl"`
+					Bytes  []byte `validate:"base64"`
+				}{
+					Std:    "aGk/Pz8+",
+					Raw:    "aGk",
+					URL:    "aGk_Pz8-",
+					RawURL: "aGk_Pw",
+					Bytes:  []byte("aGVsbG8="),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong base64 fields",
+			args: args{
+				v: struct {
+					Empty   string `validate:"base64"`
+					Padding string `validate:"base64"`
+					URL     string `validate:"base64"`
+					Std     string `validate:"base64:url"`
+					Padded  string `validate:"base64:rawurl"`
+					BadSpec string `validate:"base64:hex"`
+				}{
+					Padding: "aGk",
+					URL:     "aGk_Pz8-",
+					Std:     "aGk/Pz8+",
+					Padded:  "aGk=",
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 6)
+				assert.Equal(t, "Padding: is not valid base64, expected standard alphabet with padding", errs[1].Error())
+				assert.Equal(t, "Std: is not valid base64, expected url-safe alphabet with padding", errs[3].Error())
+				assert.ErrorIs(t, errs[5].Err, ErrInvalidValidatorSyntax)
+				return true
+			},
+		},
+		{
+			name: "valid struct with hex fields",
+			args: args{
+				v: struct {
+					Hex      string `validate:"hex"`
+					Digest   string `validate:"hex:64"`
+					Prefixed string `validate:"hex:0x"`
+					Plain    string `validate:"hex:0x"`
+					Address  string `validate:"hex:0x,8"`
+					Bytes    []byte `validate:"hex"`
+				}{
+					Hex:      "DeadBeef",
+					Digest:   "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+					Prefixed: "0xff00",
+					Plain:    "ff00",
+					Address:  "0XdeadBEEF",
+					Bytes:    []byte("cafe"),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong hex fields",
+			args: args{
+				v: struct {
+					Empty    string `validate:"hex"`
+					Odd      string `validate:"hex"`
+					NotHex   string `validate:"hex"`
+					Prefixed string `validate:"hex"`
+					Digest   string `validate:"hex:64"`
+					BadSpec  string `validate:"hex:x"`
+					Double   string `validate:"hex:0x"`
+					Address  string `validate:"hex:0x,8"`
+					BadLen   string `validate:"hex:0x,0"`
+					Suffix   string `validate:"hex:8,0x"`
+				}{
+					Odd:      "abc",
+					NotHex:   "zz",
+					Prefixed: "0xff",
+					Digest:   "e3b0c442",
+					Double:   "0x0Xab",
+					Address:  "0xdead",
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 10)
+				assert.Equal(t, "Odd: is not valid hex", errs[1].Error())
+				assert.Equal(t, "Digest: hex length 8 is not equal to 64", errs[4].Error())
+				assert.ErrorIs(t, errs[5].Err, ErrInvalidValidatorSyntax)
+				assert.Equal(t, "Double: is not valid hex", errs[6].Error())
+				assert.Equal(t, "Address: hex length 4 is not equal to 8", errs[7].Error())
+				assert.ErrorIs(t, errs[8].Err, ErrInvalidValidatorSyntax)
+				assert.ErrorIs(t, errs[9].Err, ErrInvalidValidatorSyntax)
+				return true
+			},
+		},
+		{
+			name: "valid struct with datetime fields",
+			args: args{
+				v: struct {
+					Date    string `validate:"datetime:2006-01-02"`
+					Clock   string `validate:"datetime:15:04:05"`
+					Created string `validate:"datetime:rfc3339"`
+				}{
+					Date:    "2023-02-28",
+					Clock:   "23:59:01",
+					Created: "2023-02-28T23:59:01+03:00",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong datetime fields",
+			args: args{
+				v: struct {
+					Date    string `validate:"datetime:2006-01-02"`
+					Clock   string `validate:"datetime:15:04:05"`
+					Created string `validate:"datetime:rfc3339"`
+					NoSpec  string `validate:"datetime"`
+				}{
+					Date:    "2023-02-30",
+					Clock:   "23:59",
+					Created: "2023-02-28 23:59:01",
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 4)
+				assert.Equal(t, `Date: "2023-02-30" does not match datetime layout "2006-01-02"`, errs[0].Error())
+				assert.Equal(t, `Clock: "23:59" does not match datetime layout "15:04:05"`, errs[1].Error())
+				assert.Contains(t, errs[2].Error(), time.RFC3339)
+				assert.ErrorIs(t, errs[3].Err, ErrInvalidValidatorSyntax)
+				return true
+			},
+		},
+		{
+			name: "valid struct with semver fields",
+			args: args{
+				v: struct {
+					Plain      string `validate:"semver"`
+					Prefixed   string `validate:"semver"`
+					PreRelease string `validate:"semver:strict"`
+					Build      string `validate:"semver:strict"`
+					Full       string `validate:"semver"`
+				}{
+					Plain:      "0.1.0",
+					Prefixed:   "v2.10.3",
+					PreRelease: "1.0.0-alpha.beta.1",
+					Build:      "1.0.0+20230228.sha-5114f85",
+					Full:       "1.0.0-rc.1+build.5",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong semver fields",
+			args: args{
+				v: struct {
+					Short      string `validate:"semver"`
+					LeadingV   string `validate:"semver:strict"`
+					LeadZero   string `validate:"semver"`
+					PreZero    string `validate:"semver"`
+					EmptyIdent string `validate:"semver"`
+					EmptyBuild string `validate:"semver"`
+					BadSpec    string `validate:"semver:loose"`
+				}{
+					Short:      "1.0",
+					LeadingV:   "v1.0.0",
+					LeadZero:   "01.0.0",
+					PreZero:    "1.0.0-rc.01",
+					EmptyIdent: "1.0.0-rc..1",
+					EmptyBuild: "1.0.0+",
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 7)
+				assert.Equal(t, "LeadingV: is not a valid semantic version", errs[1].Error())
+				assert.ErrorIs(t, errs[6].Err, ErrInvalidValidatorSyntax)
+				return true
+			},
+		},
+		{
+			name: "valid struct with e164 fields",
+			args: args{
+				v: struct {
+					Phone string `validate:"e164"`
+					UK    string `validate:"e164:+44"`
+				}{
+					Phone: "+15551234567",
+					UK:    "+447911123456",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong e164 fields",
+			args: args{
+				v: struct {
+					Formatted string `validate:"e164"`
+					NoPlus    string `validate:"e164"`
+					Short     string `validate:"e164"`
+					Long      string `validate:"e164"`
+					Country   string `validate:"e164:+44"`
+					BadSpec   string `validate:"e164:44"`
+				}{
+					Formatted: "+1 (555) 123",
+					NoPlus:    "15551234567",
+					Short:     "+1555123",
+					Long:      "+1555123456789012",
+					Country:   "+15551234567",
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 6)
+				assert.Equal(t, "Formatted: is not an E.164 phone number, expected + followed by 8-15 digits", errs[0].Error())
+				assert.Equal(t, "Country: phone number does not start with calling code +44", errs[4].Error())
+				assert.ErrorIs(t, errs[5].Err, ErrInvalidValidatorSyntax)
+				return true
+			},
+		},
+		{
+			name: "valid struct with creditcard fields",
+			args: args{
+				v: struct {
+					Visa       string `validate:"creditcard"`
+					Mastercard string `validate:"creditcard"`
+					Amex       string `validate:"creditcard"`
+				}{
+					Visa:       "4111111111111111",
+					Mastercard: "5500 0000 0000 0004",
+					Amex:       "3782-822463-10005",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong creditcard fields",
+			args: args{
+				v: struct {
+					OffByOne string `validate:"creditcard"`
+					Short    string `validate:"creditcard"`
+					Letters  string `validate:"creditcard"`
+					BadSpec  string `validate:"creditcard:visa"`
+				}{
+					OffByOne: "4111111111111112",
+					Short:    "41111111111",
+					Letters:  "4111-1111-1111-111a",
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 4)
+				assert.Equal(t, "OffByOne: card number ************1112 has invalid checksum", errs[0].Error())
+				assert.NotContains(t, errs[0].Error(), "4111111111111112")
+				assert.Equal(t, "Short: is not a card number, expected 12-19 digits", errs[1].Error())
+				assert.ErrorIs(t, errs[3].Err, ErrInvalidValidatorSyntax)
+				return true
+			},
+		},
+		{
+			name: "valid struct with rune length fields",
+			args: args{
+				v: struct {
+					Japanese  string `validate:"runelen:2"`
+					Cyrillic  string `validate:"runemin:3;runemax:6"`
+					Combining string `validate:"runelen:2"`
+					Emoji     string `validate:"runemax:1"`
+				}{
+					Japanese:  "日本",
+					Cyrillic:  "привет",
+					Combining: "e\u0301",
+					Emoji:     "🙂",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong rune length fields",
+			args: args{
+				v: struct {
+					Japanese  string `validate:"runelen:3"`
+					Cyrillic  string `validate:"runemax:5"`
+					Combining string `validate:"runelen:1"`
+					Short     string `validate:"runemin:3"`
+					BadSpec   string `validate:"runelen:x"`
+				}{
+					Japanese:  "日本",
+					Cyrillic:  "привет",
+					Combining: "e\u0301",
+					Short:     "日本",
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 5)
+				assert.Equal(t, "Japanese: len of 2 runes is not equal to 3", errs[0].Error())
+				assert.Equal(t, "Cyrillic: len of 6 runes is higher than max allowed 5", errs[1].Error())
+				assert.Equal(t, "Combining: len of 2 runes is not equal to 1", errs[2].Error())
+				assert.Equal(t, "Short: len of 2 runes is less than min allowed 3", errs[3].Error())
+				assert.ErrorIs(t, errs[4].Err, ErrInvalidValidatorSyntax)
+				return true
+			},
+		},
+		{
+			name: "valid struct with whitespace fields",
+			args: args{
+				v: struct {
+					Username string `validate:"no_whitespace"`
+					Empty    string `validate:"no_whitespace"`
+					Title    string `validate:"single_line"`
+				}{
+					Username: "gopher_42",
+					Title:    "hello, \tworld",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong whitespace fields",
+			args: args{
+				v: struct {
+					Space   string `validate:"no_whitespace"`
+					Tab     string `validate:"no_whitespace"`
+					NBSP    string `validate:"no_whitespace"`
+					Title   string `validate:"single_line"`
+					CR      string `validate:"single_line"`
+					BadSpec string `validate:"no_whitespace:tabs"`
+				}{
+					Space: "go pher",
+					Tab:   "go\tpher",
+					NBSP:  "日本\u00a0語",
+					Title: "hello\nworld",
+					CR:    "hello\r",
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 6)
+				assert.Equal(t, `Space: "go pher" contains whitespace U+0020 at index 2`, errs[0].Error())
+				assert.Equal(t, `NBSP: "日本\u00a0語" contains whitespace U+00A0 at index 6`, errs[2].Error())
+				assert.Equal(t, `Title: "hello\nworld" contains line break at index 5`, errs[3].Error())
+				assert.ErrorIs(t, errs[5].Err, ErrInvalidValidatorSyntax)
+				return true
+			},
+		},
+		{
+			name: "valid struct with slug fields",
+			args: args{
+				v: struct {
+					Slug       string `validate:"slug"`
+					Single     string `validate:"slug"`
+					Underscore string `validate:"slug:underscore"`
+				}{
+					Slug:       "hello-world-2023",
+					Single:     "a",
+					Underscore: "snake_case-and-kebab",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong slug fields",
+			args: args{
+				v: struct {
+					Empty      string `validate:"slug"`
+					Upper      string `validate:"slug"`
+					Leading    string `validate:"slug"`
+					Trailing   string `validate:"slug"`
+					Double     string `validate:"slug"`
+					Underscore string `validate:"slug"`
+					Mixed      string `validate:"slug:underscore"`
+					BadSpec    string `validate:"slug:dots"`
+				}{
+					Upper:      "Hello-World",
+					Leading:    "-hello",
+					Trailing:   "hello-",
+					Double:     "hello--world",
+					Underscore: "hello_world",
+					Mixed:      "hello_-world",
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 8)
+				assert.Equal(t, `Empty: "" is not a valid slug`, errs[0].Error())
+				assert.Equal(t, `Double: "hello--world" is not a valid slug`, errs[4].Error())
+				assert.ErrorIs(t, errs[7].Err, ErrInvalidValidatorSyntax)
+				return true
+			},
+		},
+		{
+			name: "valid struct with isbn fields",
+			args: args{
+				v: struct {
+					Any       string `validate:"isbn"`
+					Ten       string `validate:"isbn:10"`
+					CheckX    string `validate:"isbn:10"`
+					Thirteen  string `validate:"isbn:13"`
+					Formatted string `validate:"isbn"`
+				}{
+					Any:       "9780306406157",
+					Ten:       "0306406152",
+					CheckX:    "0-8044-2957-X",
+					Thirteen:  "978-0-306-40615-7",
+					Formatted: "0 306 40615 2",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong isbn fields",
+			args: args{
+				v: struct {
+					Checksum   string `validate:"isbn"`
+					Ten        string `validate:"isbn:10"`
+					Thirteen   string `validate:"isbn:13"`
+					MisplacedX string `validate:"isbn"`
+					BadSpec    string `validate:"isbn:12"`
+				}{
+					Checksum:   "9780306406158",
+					Ten:        "9780306406157",
+					Thirteen:   "0306406152",
+					MisplacedX: "030640615X",
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 5)
+				assert.Equal(t, "Checksum: 9780306406158 is not a valid ISBN", errs[0].Error())
+				assert.Equal(t, "Ten: 9780306406157 is not a valid ISBN-10", errs[1].Error())
+				assert.ErrorIs(t, errs[4].Err, ErrInvalidValidatorSyntax)
+				return true
+			},
+		},
+		{
+			name: "valid struct with country_code fields",
+			args: args{
+				v: struct {
+					Alpha2 string `validate:"country_code"`
+					Lower  string `validate:"country_code:alpha2"`
+					Alpha3 string `validate:"country_code:alpha3"`
+				}{
+					Alpha2: "GB",
+					Lower:  "de",
+					Alpha3: "usa",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong country_code fields",
+			args: args{
+				v: struct {
+					UK      string `validate:"country_code"`
+					Alpha3  string `validate:"country_code"`
+					Alpha2  string `validate:"country_code:alpha3"`
+					Empty   string `validate:"country_code"`
+					BadSpec string `validate:"country_code:numeric"`
+				}{
+					UK:     "uk",
+					Alpha3: "gbr",
+					Alpha2: "GB",
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 5)
+				assert.Equal(t, "UK: UK is not an ISO 3166-1 alpha-2 country code", errs[0].Error())
+				assert.Equal(t, "Alpha3: GBR is not an ISO 3166-1 alpha-2 country code, did you mean GB", errs[1].Error())
+				assert.Equal(t, "Alpha2: GB is not an ISO 3166-1 alpha-3 country code, did you mean GBR", errs[2].Error())
+				assert.ErrorIs(t, errs[4].Err, ErrInvalidValidatorSyntax)
+				return true
+			},
+		},
+		{
+			name: "valid struct with currency_code fields",
+			args: args{
+				v: struct {
+					USD   string `validate:"currency_code"`
+					Lower string `validate:"currency_code"`
+					JPY   string `validate:"currency_code"`
+				}{
+					USD:   "USD",
+					Lower: "eur",
+					JPY:   "JPY",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong currency_code fields",
+			args: args{
+				v: struct {
+					Typo    string `validate:"currency_code"`
+					Long    string `validate:"currency_code"`
+					Empty   string `validate:"currency_code"`
+					BadSpec string `validate:"currency_code:numeric"`
+				}{
+					Typo: "EUO",
+					Long: "USDT",
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 4)
+				assert.Equal(t, "Typo: EUO is not a known ISO 4217 currency code", errs[0].Error())
+				assert.ErrorIs(t, errs[3].Err, ErrInvalidValidatorSyntax)
+				return true
+			},
+		},
+		{
+			name: "valid struct with timezone fields",
+			args: args{
+				v: struct {
+					Berlin string `validate:"timezone"`
+					Tokyo  string `validate:"timezone"`
+					EtcUTC string `validate:"timezone"`
+					UTC    string `validate:"timezone:local"`
+					Local  string `validate:"timezone:local"`
+				}{
+					Berlin: "Europe/Berlin",
+					Tokyo:  "Asia/Tokyo",
+					EtcUTC: "Etc/UTC",
+					UTC:    "UTC",
+					Local:  "Local",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong timezone fields",
+			args: args{
+				v: struct {
+					Unknown  string `validate:"timezone"`
+					Offset   string `validate:"timezone"`
+					Traverse string `validate:"timezone"`
+					UTC      string `validate:"timezone"`
+					Empty    string `validate:"timezone:local"`
+					BadSpec  string `validate:"timezone:utc"`
+				}{
+					Unknown:  "Mars/Olympus_Mons",
+					Offset:   "EST5EDT6",
+					Traverse: "../../etc/passwd",
+					UTC:      "UTC",
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 6)
+				assert.Equal(t, "Unknown: Mars/Olympus_Mons is not a valid IANA timezone", errs[0].Error())
+				assert.Equal(t, "Offset: EST5EDT6 is not a valid IANA timezone", errs[1].Error())
+				assert.Equal(t, "UTC: UTC is not allowed as timezone", errs[3].Error())
+				assert.Equal(t, "Empty: timezone is empty", errs[4].Error())
+				assert.ErrorIs(t, errs[5].Err, ErrInvalidValidatorSyntax)
+				return true
+			},
+		},
+		{
+			name: "valid struct with coordinate fields",
+			args: args{
+				v: struct {
+					Lat       float64 `validate:"latitude"`
+					Lng       float64 `validate:"longitude"`
+					LatStr    string  `validate:"latitude"`
+					LngStr    string  `validate:"longitude"`
+					NorthPole float32 `validate:"latitude"`
+					DateLine  float64 `validate:"longitude"`
+				}{
+					Lat:       52.52,
+					Lng:       13.405,
+					LatStr:    "-33.8688",
+					LngStr:    "151.2093",
+					NorthPole: 90,
+					DateLine:  -180,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong coordinate fields",
+			args: args{
+				v: struct {
+					Lat     float64 `validate:"latitude"`
+					Lng     float64 `validate:"longitude"`
+					NaN     float64 `validate:"latitude"`
+					Inf     float64 `validate:"longitude"`
+					LatStr  string  `validate:"latitude"`
+					NotNum  string  `validate:"longitude"`
+					StrNaN  string  `validate:"latitude"`
+					BadSpec float64 `validate:"latitude:deg"`
+				}{
+					Lat:    90.5,
+					Lng:    -180.1,
+					NaN:    math.NaN(),
+					Inf:    math.Inf(1),
+					LatStr: "91",
+					NotNum: "east",
+					StrNaN: "NaN",
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 8)
+				assert.Equal(t, "Lat: 90.5 is not a valid latitude in range -90..90", errs[0].Error())
+				assert.Equal(t, "Lng: -180.1 is not a valid longitude in range -180..180", errs[1].Error())
+				assert.Equal(t, "NaN: NaN is not a valid latitude in range -90..90", errs[2].Error())
+				assert.Equal(t, "Inf: +Inf is not a valid longitude in range -180..180", errs[3].Error())
+				assert.Equal(t, `NotNum: "east" is not a valid longitude`, errs[5].Error())
+				assert.ErrorIs(t, errs[7].Err, ErrInvalidValidatorSyntax)
+				return true
+			},
+		},
+		{
+			name: "valid struct with cidr fields",
+			args: args{
+				v: struct {
+					Any      string `validate:"cidr"`
+					HostBits string `validate:"cidr"`
+					All      string `validate:"cidr:ipv4,strict"`
+					Host     string `validate:"cidr:ipv4,strict"`
+					AllV6    string `validate:"cidr:ipv6,strict"`
+					HostV6   string `validate:"cidr:ipv6"`
+				}{
+					Any:      "2001:db8::/32",
+					HostBits: "10.0.0.5/8",
+					All:      "0.0.0.0/0",
+					Host:     "10.0.0.5/32",
+					AllV6:    "::/0",
+					HostV6:   "2001:db8::1/128",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong cidr fields",
+			args: args{
+				v: struct {
+					NoPrefix string `validate:"cidr"`
+					TooLong  string `validate:"cidr"`
+					V4       string `validate:"cidr:ipv4"`
+					V6       string `validate:"cidr:ipv6"`
+					HostBits string `validate:"cidr:strict"`
+					BadSpec  string `validate:"cidr:ipv4,ipv6"`
+				}{
+					NoPrefix: "10.0.0.0",
+					TooLong:  "10.0.0.0/33",
+					V4:       "2001:db8::/32",
+					V6:       "10.0.0.0/8",
+					HostBits: "10.0.0.5/8",
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 6)
+				assert.Equal(t, "NoPrefix: 10.0.0.0 is not a valid cidr", errs[0].Error())
+				assert.Equal(t, "V4: 2001:db8::/32 is not a valid ipv4 cidr", errs[2].Error())
+				assert.Equal(t, "HostBits: 10.0.0.5/8 has host bits set, expected 10.0.0.0/8", errs[4].Error())
+				assert.ErrorIs(t, errs[5].Err, ErrInvalidValidatorSyntax)
+				return true
+			},
+		},
+		{
+			name: "valid struct with fqdn fields",
+			args: args{
+				v: struct {
+					API      string `validate:"fqdn"`
+					Rooted   string `validate:"fqdn"`
+					Punycode string `validate:"fqdn"`
+				}{
+					API:      "api.example.com",
+					Rooted:   "example.com.",
+					Punycode: "xn--80ak6aa92e.com",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong fqdn fields",
+			args: args{
+				v: struct {
+					Bare       string `validate:"fqdn"`
+					DoubleDot  string `validate:"fqdn"`
+					TwoDots    string `validate:"fqdn"`
+					IP         string `validate:"fqdn"`
+					Underscore string `validate:"fqdn"`
+					BadSpec    string `validate:"fqdn:strict"`
+				}{
+					Bare:       "localhost",
+					DoubleDot:  "api..example.com",
+					TwoDots:    "example.com..",
+					IP:         "10.0.0.1",
+					Underscore: "my_host.example.com",
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 6)
+				assert.Equal(t, "Bare: localhost is not a fully qualified domain name", errs[0].Error())
+				assert.Equal(t, "DoubleDot: api..example.com is not a valid fqdn", errs[1].Error())
+				assert.Equal(t, "IP: 10.0.0.1 has numeric top-level domain", errs[3].Error())
+				assert.ErrorIs(t, errs[5].Err, ErrInvalidValidatorSyntax)
+				return true
+			},
+		},
+		{
+			name: "valid struct with jwt fields",
+			args: args{
+				v: struct {
+					Token string `validate:"jwt"`
+				}{
+					Token: "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9." +
+						"eyJzdWIiOiIxMjM0NTY3ODkwIiwibmFtZSI6IkpvaG4gRG9lIiwiaWF0IjoxNTE2MjM5MDIyfQ." +
+						"SflKxwRJSMeKKF2QT4fwpMeJf36POk6yJV_adQssw5c",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong jwt fields",
+			args: args{
+				v: struct {
+					TwoParts  string `validate:"jwt"`
+					NoSig     string `validate:"jwt"`
+					BadBase64 string `validate:"jwt"`
+					NotJSON   string `validate:"jwt"`
+					NoAlg     string `validate:"jwt"`
+					BadSpec   string `validate:"jwt:hs256"`
+				}{
+					TwoParts:  "eyJhbGciOiJIUzI1NiJ9.eyJzdWIiOiIxIn0",
+					NoSig:     "eyJhbGciOiJIUzI1NiJ9.eyJzdWIiOiIxIn0.",
+					BadBase64: "eyJhbGciOiJIUzI1NiJ9.eyJzdWIi+IxIn0.c2ln",
+					NotJSON:   "bm90IGpzb24.eyJzdWIiOiIxIn0.c2ln",
+					NoAlg:     "eyJ0eXAiOiJKV1QifQ.eyJzdWIiOiIxIn0.c2ln",
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 6)
+				assert.Equal(t, "TwoParts: jwt must have 3 segments, got 2", errs[0].Error())
+				assert.Equal(t, "NoSig: jwt signature segment is empty", errs[1].Error())
+				assert.Equal(t, "BadBase64: jwt payload segment is not valid base64url", errs[2].Error())
+				assert.Equal(t, "NotJSON: jwt header is not a json object", errs[3].Error())
+				assert.Equal(t, "NoAlg: jwt header has no alg field", errs[4].Error())
+				for _, e := range errs[:5] {
+					assert.NotContains(t, e.Error(), "eyJ")
+				}
+				assert.ErrorIs(t, errs[5].Err, ErrInvalidValidatorSyntax)
+				return true
+			},
+		},
+		{
+			name: "valid struct with color fields",
+			args: args{
+				v: struct {
+					Short string `validate:"hexcolor"`
+					Long  string `validate:"hexcolor"`
+					Alpha string `validate:"hexcolor"`
+					Six   string `validate:"hexcolor:6"`
+					Hex   string `validate:"color"`
+					RGB   string `validate:"color"`
+					RGBA  string `validate:"color"`
+					HSL   string `validate:"color"`
+					Upper string `validate:"color"`
+				}{
+					Short: "#fA0",
+					Long:  "#FFAA00",
+					Alpha: "#ffaa0080",
+					Six:   "#00ff00",
+					Hex:   "#abc",
+					RGB:   "rgb(255, 0, 128)",
+					RGBA:  "rgba(255,0,128,.5)",
+					HSL:   "hsl(120, 100%, 50%)",
+					Upper: "RGB(0,0,0)",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong color fields",
+			args: args{
+				v: struct {
+					NoHash   string `validate:"hexcolor"`
+					Five     string `validate:"hexcolor"`
+					NotHex   string `validate:"hexcolor"`
+					Short    string `validate:"hexcolor:6"`
+					Named    string `validate:"color"`
+					RGB      string `validate:"color"`
+					HSL      string `validate:"color"`
+					BadSpec  string `validate:"hexcolor:4"`
+					BadColor string `validate:"color:hex"`
+				}{
+					NoHash: "ffaa00",
+					Five:   "#ffaa0",
+					NotHex: "#ggg",
+					Short:  "#fa0",
+					Named:  "red",
+					RGB:    "rgb(255, 0)",
+					HSL:    "hsl(120, 100, 50)",
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 9)
+				assert.Equal(t, "NoHash: ffaa00 is not a valid hex color, expected #RGB or #RRGGBB or #RRGGBBAA", errs[0].Error())
+				assert.Equal(t, "Short: #fa0 is not a valid hex color, expected #RRGGBB", errs[3].Error())
+				assert.Equal(t, "Named: red is not a valid color, expected hex or rgb(), rgba(), hsl(), hsla()", errs[4].Error())
+				assert.Equal(t, "RGB: rgb(255, 0) is not a valid rgb() color", errs[5].Error())
+				assert.Equal(t, "HSL: hsl(120, 100, 50) is not a valid hsl() color", errs[6].Error())
+				assert.ErrorIs(t, errs[7].Err, ErrInvalidValidatorSyntax)
+				assert.ErrorIs(t, errs[8].Err, ErrInvalidValidatorSyntax)
+				return true
+			},
+		},
+		{
+			name: "valid struct with in_ci fields",
+			args: args{
+				v: struct {
+					Method  string   `validate:"in_ci:GET,POST,PUT"`
+					Exact   string   `validate:"in_ci:GET,POST,PUT"`
+					Methods []string `validate:"in_ci:GET,POST"`
+				}{
+					Method:  "get",
+					Exact:   "PUT",
+					Methods: []string{"Post", "gEt"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong in_ci fields",
+			args: args{
+				v: struct {
+					Method string `validate:"in_ci:GET,POST,PUT"`
+					Status int    `validate:"in_ci:1,2"`
+					NoSpec string `validate:"in_ci"`
+				}{
+					Method: "patch",
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 3)
+				assert.Equal(t, "Method: patch is not in [GET POST PUT]", errs[0].Error())
+				assert.ErrorIs(t, errs[1].Err, ErrInvalidValidatorSyntax)
+				assert.ErrorIs(t, errs[2].Err, ErrInvalidValidatorSyntax)
+				return true
+			},
+		},
+		{
+			name: "valid struct with eq and ne fields",
+			args: args{
+				v: struct {
+					Version  string `validate:"eq:v1"`
+					Profile  string `validate:"ne:default"`
+					Empty    string `validate:"eq:"`
+					NotEmpty string `validate:"ne:"`
+					Literal  string `validate:"eq:a:b,c"`
+					Escaped  string `validate:"eq:a\\;b"`
+				}{
+					Version:  "v1",
+					Profile:  "staging",
+					NotEmpty: "x",
+					Literal:  "a:b,c",
+					Escaped:  "a;b",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong eq and ne fields",
+			args: args{
+				v: struct {
+					Version  string `validate:"eq:v1"`
+					Profile  string `validate:"ne:default"`
+					Empty    string `validate:"eq:"`
+					NotEmpty string `validate:"ne:"`
+					MinEmpty string `validate:"min:"`
+					BoolEq   bool   `validate:"eq:"`
+				}{
+					Version: "v2",
+					Profile: "default",
+					Empty:   " ",
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 6)
+				assert.Equal(t, `Version: "v2" is not equal to "v1"`, errs[0].Error())
+				assert.Equal(t, `Profile: "default" is not allowed`, errs[1].Error())
+				assert.Equal(t, `Empty: " " is not equal to ""`, errs[2].Error())
+				assert.Equal(t, `NotEmpty: "" is not allowed`, errs[3].Error())
+				assert.ErrorIs(t, errs[4].Err, ErrInvalidValidatorSyntax)
+				assert.ErrorIs(t, errs[5].Err, ErrInvalidValidatorSyntax)
+				return true
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.args.v)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.True(t, tt.checkErr(err), "test expect an error, but got wrong error type")
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,262 @@
+package validator
+
+import (
+	"fmt"
+	"github.com/pkg/errors"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+)
+
+type timeParam struct {
+	t   time.Time
+	now bool
+}
+
+func (p timeParam) get() time.Time {
+	if p.now {
+		return time.Now()
+	}
+	return p.t
+}
+
+func (p timeParam) String() string {
+	if p.now {
+		return "now"
+	}
+	return p.t.Format(time.RFC3339Nano)
+}
+
+func parseTime(s string) (timeParam, error) {
+	if s == "now" {
+		return timeParam{now: true}, nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return timeParam{}, err
+	}
+	return timeParam{t: t}, nil
+}
+
+func parseDuration(s string) (time.Duration, error) {
+	d, err := time.ParseDuration(s)
+	if err == nil {
+		return d, nil
+	}
+	ns, intErr := parseInt(s)
+	if intErr != nil {
+		return 0, err
+	}
+	return time.Duration(ns), nil
+}
+
+func parseDurationSlice(s string) ([]time.Duration, error) {
+	strVals := strings.Split(s, ",")
+	durationVals := make([]time.Duration, 0, len(strVals))
+	for _, v := range strVals {
+		val, err := parseDuration(v)
+		if err != nil {
+			return nil, err
+		}
+		durationVals = append(durationVals, val)
+	}
+	return durationVals, nil
+}
+
+func timeOf(v reflect.Value) time.Time {
+	return v.Convert(timeType).Interface().(time.Time)
+}
+
+type timeAfterValidator struct {
+	after timeParam
+}
+
+func (v timeAfterValidator) validate(t reflect.Value) error {
+	val := timeOf(t)
+	if !val.After(v.after.get()) {
+		return fmt.Errorf("%s is not after %s", val.Format(time.RFC3339Nano), v.after)
+	}
+	return nil
+}
+
+func newTimeAfterValidator(s string) (fieldValidator, error) {
+	val, err := parseTime(s)
+	if err != nil {
+		return nil, err
+	}
+	return timeAfterValidator{val}, nil
+}
+
+type timeBeforeValidator struct {
+	before timeParam
+}
+
+func (v timeBeforeValidator) validate(t reflect.Value) error {
+	val := timeOf(t)
+	if !val.Before(v.before.get()) {
+		return fmt.Errorf("%s is not before %s", val.Format(time.RFC3339Nano), v.before)
+	}
+	return nil
+}
+
+func newTimeBeforeValidator(s string) (fieldValidator, error) {
+	val, err := parseTime(s)
+	if err != nil {
+		return nil, err
+	}
+	return timeBeforeValidator{val}, nil
+}
+
+type timeMinValidator struct {
+	min timeParam
+}
+
+func (v timeMinValidator) validate(t reflect.Value) error {
+	val := timeOf(t)
+	if val.Before(v.min.get()) {
+		return fmt.Errorf("%s is earlier than min allowed %s", val.Format(time.RFC3339Nano), v.min)
+	}
+	return nil
+}
+
+func newTimeMinValidator(s string) (fieldValidator, error) {
+	val, err := parseTime(s)
+	if err != nil {
+		return nil, err
+	}
+	return timeMinValidator{val}, nil
+}
+
+type timeMaxValidator struct {
+	max timeParam
+}
+
+func (v timeMaxValidator) validate(t reflect.Value) error {
+	val := timeOf(t)
+	if val.After(v.max.get()) {
+		return fmt.Errorf("%s is later than max allowed %s", val.Format(time.RFC3339Nano), v.max)
+	}
+	return nil
+}
+
+func newTimeMaxValidator(s string) (fieldValidator, error) {
+	val, err := parseTime(s)
+	if err != nil {
+		return nil, err
+	}
+	return timeMaxValidator{val}, nil
+}
+
+type timeRequiredValidator struct{}
+
+func (v timeRequiredValidator) validate(t reflect.Value) error {
+	if timeOf(t).IsZero() {
+		return errors.New("is required")
+	}
+	return nil
+}
+
+func newTimeRequiredValidator(s string) (fieldValidator, error) {
+	if len(s) != 0 {
+		return nil, ErrInvalidValidatorSyntax
+	}
+	return timeRequiredValidator{}, nil
+}
+
+type durationMinValidator struct {
+	min time.Duration
+}
+
+func (v durationMinValidator) validate(d reflect.Value) error {
+	val := time.Duration(d.Int())
+	if val < v.min {
+		return fmt.Errorf("%s is less than min allowed %s", val, v.min)
+	}
+	return nil
+}
+
+func newDurationMinValidator(s string) (fieldValidator, error) {
+	val, err := parseDuration(s)
+	if err != nil {
+		return nil, err
+	}
+	return durationMinValidator{val}, nil
+}
+
+type durationMaxValidator struct {
+	max time.Duration
+}
+
+func (v durationMaxValidator) validate(d reflect.Value) error {
+	val := time.Duration(d.Int())
+	if val > v.max {
+		return fmt.Errorf("%s is higher than max allowed %s", val, v.max)
+	}
+	return nil
+}
+
+func newDurationMaxValidator(s string) (fieldValidator, error) {
+	val, err := parseDuration(s)
+	if err != nil {
+		return nil, err
+	}
+	return durationMaxValidator{val}, nil
+}
+
+type durationInValidator struct {
+	in []time.Duration
+}
+
+func (v durationInValidator) validate(d reflect.Value) error {
+	val := time.Duration(d.Int())
+	if !contains(v.in, val) {
+		return fmt.Errorf("%s is not in %v", val, v.in)
+	}
+	return nil
+}
+
+func newDurationInValidator(s string) (fieldValidator, error) {
+	vals, err := parseDurationSlice(s)
+	if err != nil {
+		return nil, err
+	}
+	return durationInValidator{vals}, nil
+}
+
+var loadedLocations sync.Map
+
+type timezoneValidator struct {
+	local bool
+}
+
+func (v timezoneValidator) validate(s reflect.Value) error {
+	name := s.String()
+	switch name {
+	case "":
+		return errors.New("timezone is empty")
+	case "UTC", "Local":
+		if !v.local {
+			return fmt.Errorf("%s is not allowed as timezone", name)
+		}
+		return nil
+	}
+	if _, ok := loadedLocations.Load(name); ok {
+		return nil
+	}
+	if _, err := time.LoadLocation(name); err != nil {
+		return fmt.Errorf("%s is not a valid IANA timezone", name)
+	}
+	loadedLocations.Store(name, struct{}{})
+	return nil
+}
+
+func newTimezoneValidator(s string) (fieldValidator, error) {
+	switch s {
+	case "":
+		return timezoneValidator{}, nil
+	case "local":
+		return timezoneValidator{local: true}, nil
+	}
+	return nil, ErrInvalidValidatorSyntax
+}
@@ -1,8 +1,12 @@
 package validator
 
 import (
+	"context"
 	"fmt"
+	"github.com/pkg/errors"
+	"math"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 )
@@ -16,83 +20,807 @@ func contains[T comparable](s []T, needle T) bool {
 	return false
 }
 
-func parseIntSlice(s string) ([]int, error) {
+func splitEscaped(s string, sep byte) []string {
+	parts := make([]string, 0, strings.Count(s, string(sep))+1)
+	var part strings.Builder
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == '\\' && i+1 < len(s) && s[i+1] == sep:
+			part.WriteByte(sep)
+			i++
+		case s[i] == sep:
+			parts = append(parts, part.String())
+			part.Reset()
+		default:
+			part.WriteByte(s[i])
+		}
+	}
+	return append(parts, part.String())
+}
+
+func parseStrSlice(s string) []string {
+	return splitEscaped(s, ',')
+}
+
+func parseInt(s string) (int64, error) {
+	return strconv.ParseInt(s, 10, 64)
+}
+
+func parseIntSlice(s string) ([]int64, error) {
 	strVals := strings.Split(s, ",")
-	intVals := make([]int, 0, len(strVals))
+	intVals := make([]int64, 0, len(strVals))
 	for _, v := range strVals {
-		val, err := strconv.Atoi(v)
+		val, err := parseInt(v)
 		if err != nil {
 			return nil, err
 		}
 		intVals = append(intVals, val)
 	}
-	return intVals, nil
+	return intVals, nil
+}
+
+func parseUint(s string) (uint64, error) {
+	return strconv.ParseUint(s, 10, 64)
+}
+
+func parseUintSlice(s string) ([]uint64, error) {
+	strVals := strings.Split(s, ",")
+	uintVals := make([]uint64, 0, len(strVals))
+	for _, v := range strVals {
+		val, err := parseUint(v)
+		if err != nil {
+			return nil, err
+		}
+		uintVals = append(uintVals, val)
+	}
+	return uintVals, nil
+}
+
+func parseFloat(s string) (float64, error) {
+	return strconv.ParseFloat(s, 64)
+}
+
+func parseFloatSlice(s string) ([]float64, error) {
+	strVals := strings.Split(s, ",")
+	floatVals := make([]float64, 0, len(strVals))
+	for _, v := range strVals {
+		val, err := parseFloat(v)
+		if err != nil {
+			return nil, err
+		}
+		floatVals = append(floatVals, val)
+	}
+	return floatVals, nil
+}
+
+func parseBool(s string) (bool, error) {
+	switch s {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	}
+	return false, ErrInvalidValidatorSyntax
+}
+
+func parseBoolSlice(s string) ([]bool, error) {
+	strVals := strings.Split(s, ",")
+	boolVals := make([]bool, 0, len(strVals))
+	for _, v := range strVals {
+		val, err := parseBool(v)
+		if err != nil {
+			return nil, err
+		}
+		boolVals = append(boolVals, val)
+	}
+	return boolVals, nil
+}
+
+func sortedKeys(m reflect.Value) []reflect.Value {
+	keys := m.MapKeys()
+	sort.Slice(keys, func(i, j int) bool {
+		return keyLess(keys[i], keys[j])
+	})
+	return keys
+}
+
+func keyLess(a, b reflect.Value) bool {
+	switch a.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return a.Int() < b.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return a.Uint() < b.Uint()
+	case reflect.Float32, reflect.Float64:
+		return a.Float() < b.Float() || math.IsNaN(a.Float()) && !math.IsNaN(b.Float())
+	}
+	return fmt.Sprint(a) < fmt.Sprint(b)
+}
+
+type fieldValidator interface {
+	validate(reflect.Value) error
+}
+
+type fieldValidatorCreator func(string) (fieldValidator, error)
+
+type ctxValidator interface {
+	fieldValidator
+	validateCtx(context.Context, reflect.Value) error
+}
+
+func validateWithCtx(ctx context.Context, validator fieldValidator, v reflect.Value) error {
+	if validator, ok := validator.(ctxValidator); ok {
+		return validator.validateCtx(ctx, v)
+	}
+	return validator.validate(v)
+}
+
+type ruleValidator struct {
+	rule      string
+	param     string
+	validator fieldValidator
+}
+
+func newRuleValidator(rule, param string, create fieldValidatorCreator) (fieldValidator, error) {
+	validator, err := create(param)
+	if err != nil {
+		return nil, err
+	}
+	return ruleValidator{rule, param, validator}, nil
+}
+
+func (v ruleValidator) validate(f reflect.Value) error {
+	return v.validateCtx(context.Background(), f)
+}
+
+func (v ruleValidator) validateCtx(ctx context.Context, f reflect.Value) error {
+	err := validateWithCtx(ctx, v.validator, f)
+	if err == nil {
+		return nil
+	}
+	return ValidationError{Rule: v.rule, Param: v.param, Value: ruleValueString(v.rule, f), Err: err}
+}
+
+var redactedRules = map[string]func(string) string{
+	"creditcard": maskCardValue,
+	"jwt":        func(string) string { return "" },
+}
+
+func ruleValueString(rule string, v reflect.Value) string {
+	s := valueString(v)
+	if redact, ok := redactedRules[rule]; ok {
+		return redact(s)
+	}
+	return s
+}
+
+func maskCardValue(s string) string {
+	digits := strings.Map(func(r rune) rune {
+		if !isASCIIDigit(r) {
+			return -1
+		}
+		return r
+	}, s)
+	if len(digits) <= 4 {
+		return strings.Repeat("*", len(digits))
+	}
+	return maskCardNumber(digits)
+}
+
+func valueString(v reflect.Value) string {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return ""
+		}
+		v = v.Elem()
+	}
+	if !v.CanInterface() {
+		return ""
+	}
+	return fmt.Sprint(v.Interface())
+}
+
+type intMinValidator struct {
+	min int64
+}
+
+func (v intMinValidator) validate(i reflect.Value) error {
+	val := i.Int()
+	if val < v.min {
+		return fmt.Errorf("%d is less than min allowed %d", val, v.min)
+	}
+	return nil
+}
+
+func newIntMinValidator(s string) (fieldValidator, error) {
+	val, err := parseInt(s)
+	if err != nil {
+		return nil, err
+	}
+	return intMinValidator{val}, nil
+}
+
+type intMaxValidator struct {
+	max int64
+}
+
+func (v intMaxValidator) validate(i reflect.Value) error {
+	val := i.Int()
+	if val > v.max {
+		return fmt.Errorf("%d is higher than max allowed %d", val, v.max)
+	}
+	return nil
+}
+
+func newIntMaxValidator(s string) (fieldValidator, error) {
+	val, err := parseInt(s)
+	if err != nil {
+		return nil, err
+	}
+	return intMaxValidator{val}, nil
+}
+
+type intInValidator struct {
+	in []int64
+}
+
+func (v intInValidator) validate(i reflect.Value) error {
+	val := i.Int()
+	if !contains(v.in, val) {
+		return fmt.Errorf("%d is not in %v", val, v.in)
+	}
+	return nil
+}
+
+func newIntInValidator(s string) (fieldValidator, error) {
+	vals, err := parseIntSlice(s)
+	if err != nil {
+		return nil, err
+	}
+	return intInValidator{vals}, nil
+}
+
+type intEqValidator struct {
+	val int64
+	ne  bool
+}
+
+func (v intEqValidator) validate(i reflect.Value) error {
+	val := i.Int()
+	switch {
+	case v.ne && val == v.val:
+		return fmt.Errorf("must not equal %d", v.val)
+	case !v.ne && val != v.val:
+		return fmt.Errorf("must equal %d, got %d", v.val, val)
+	}
+	return nil
+}
+
+func newIntEqValidator(s string) (fieldValidator, error) {
+	val, err := parseInt(s)
+	if err != nil {
+		return nil, err
+	}
+	return intEqValidator{val: val}, nil
+}
+
+func newIntNeValidator(s string) (fieldValidator, error) {
+	val, err := parseInt(s)
+	if err != nil {
+		return nil, err
+	}
+	return intEqValidator{val: val, ne: true}, nil
+}
+
+type intNotInValidator struct {
+	notIn []int64
+}
+
+func (v intNotInValidator) validate(i reflect.Value) error {
+	val := i.Int()
+	if contains(v.notIn, val) {
+		return fmt.Errorf("%d is a forbidden value", val)
+	}
+	return nil
+}
+
+func newIntNotInValidator(s string) (fieldValidator, error) {
+	vals, err := parseIntSlice(s)
+	if err != nil {
+		return nil, err
+	}
+	return intNotInValidator{vals}, nil
+}
+
+type intBetweenValidator struct {
+	min, max int64
+}
+
+func (v intBetweenValidator) validate(i reflect.Value) error {
+	val := i.Int()
+	if val < v.min || val > v.max {
+		return fmt.Errorf("%d is not between %d and %d", val, v.min, v.max)
+	}
+	return nil
+}
+
+func newIntBetweenValidator(s string) (fieldValidator, error) {
+	vals, err := parseIntSlice(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(vals) != 2 || vals[0] > vals[1] {
+		return nil, ErrInvalidValidatorSyntax
+	}
+	return intBetweenValidator{vals[0], vals[1]}, nil
+}
+
+type intGtValidator struct {
+	gt int64
+}
+
+func (v intGtValidator) validate(i reflect.Value) error {
+	val := i.Int()
+	if val <= v.gt {
+		return fmt.Errorf("%d is not greater than %d", val, v.gt)
+	}
+	return nil
+}
+
+func newIntGtValidator(s string) (fieldValidator, error) {
+	val, err := parseInt(s)
+	if err != nil {
+		return nil, err
+	}
+	return intGtValidator{val}, nil
+}
+
+type intLtValidator struct {
+	lt int64
+}
+
+func (v intLtValidator) validate(i reflect.Value) error {
+	val := i.Int()
+	if val >= v.lt {
+		return fmt.Errorf("%d is not less than %d", val, v.lt)
+	}
+	return nil
+}
+
+func newIntLtValidator(s string) (fieldValidator, error) {
+	val, err := parseInt(s)
+	if err != nil {
+		return nil, err
+	}
+	return intLtValidator{val}, nil
+}
+
+type intMultipleOfValidator struct {
+	n int64
+}
+
+func (v intMultipleOfValidator) validate(i reflect.Value) error {
+	val := i.Int()
+	if val%v.n != 0 {
+		return fmt.Errorf("%d is not a multiple of %d", val, v.n)
+	}
+	return nil
+}
+
+func newIntMultipleOfValidator(s string) (fieldValidator, error) {
+	val, err := parseInt(s)
+	if err != nil {
+		return nil, err
+	}
+	if val == 0 || val == math.MinInt64 {
+		return nil, ErrInvalidValidatorSyntax
+	}
+	if val < 0 {
+		val = -val
+	}
+	return intMultipleOfValidator{val}, nil
+}
+
+type uintMinValidator struct {
+	min uint64
+}
+
+func (v uintMinValidator) validate(i reflect.Value) error {
+	val := i.Uint()
+	if val < v.min {
+		return fmt.Errorf("%d is less than min allowed %d", val, v.min)
+	}
+	return nil
+}
+
+func newUintMinValidator(s string) (fieldValidator, error) {
+	val, err := parseUint(s)
+	if err != nil {
+		return nil, err
+	}
+	return uintMinValidator{val}, nil
+}
+
+type uintMaxValidator struct {
+	max uint64
+}
+
+func (v uintMaxValidator) validate(i reflect.Value) error {
+	val := i.Uint()
+	if val > v.max {
+		return fmt.Errorf("%d is higher than max allowed %d", val, v.max)
+	}
+	return nil
+}
+
+func newUintMaxValidator(s string) (fieldValidator, error) {
+	val, err := parseUint(s)
+	if err != nil {
+		return nil, err
+	}
+	return uintMaxValidator{val}, nil
+}
+
+type uintInValidator struct {
+	in []uint64
+}
+
+func (v uintInValidator) validate(i reflect.Value) error {
+	val := i.Uint()
+	if !contains(v.in, val) {
+		return fmt.Errorf("%d is not in %v", val, v.in)
+	}
+	return nil
+}
+
+func newUintInValidator(s string) (fieldValidator, error) {
+	vals, err := parseUintSlice(s)
+	if err != nil {
+		return nil, err
+	}
+	return uintInValidator{vals}, nil
+}
+
+type uintEqValidator struct {
+	val uint64
+	ne  bool
+}
+
+func (v uintEqValidator) validate(i reflect.Value) error {
+	val := i.Uint()
+	switch {
+	case v.ne && val == v.val:
+		return fmt.Errorf("must not equal %d", v.val)
+	case !v.ne && val != v.val:
+		return fmt.Errorf("must equal %d, got %d", v.val, val)
+	}
+	return nil
+}
+
+func newUintEqValidator(s string) (fieldValidator, error) {
+	val, err := parseUint(s)
+	if err != nil {
+		return nil, err
+	}
+	return uintEqValidator{val: val}, nil
+}
+
+func newUintNeValidator(s string) (fieldValidator, error) {
+	val, err := parseUint(s)
+	if err != nil {
+		return nil, err
+	}
+	return uintEqValidator{val: val, ne: true}, nil
+}
+
+type uintNotInValidator struct {
+	notIn []uint64
+}
+
+func (v uintNotInValidator) validate(i reflect.Value) error {
+	val := i.Uint()
+	if contains(v.notIn, val) {
+		return fmt.Errorf("%d is a forbidden value", val)
+	}
+	return nil
+}
+
+func newUintNotInValidator(s string) (fieldValidator, error) {
+	vals, err := parseUintSlice(s)
+	if err != nil {
+		return nil, err
+	}
+	return uintNotInValidator{vals}, nil
+}
+
+type uintBetweenValidator struct {
+	min, max uint64
+}
+
+func (v uintBetweenValidator) validate(i reflect.Value) error {
+	val := i.Uint()
+	if val < v.min || val > v.max {
+		return fmt.Errorf("%d is not between %d and %d", val, v.min, v.max)
+	}
+	return nil
+}
+
+func newUintBetweenValidator(s string) (fieldValidator, error) {
+	vals, err := parseUintSlice(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(vals) != 2 || vals[0] > vals[1] {
+		return nil, ErrInvalidValidatorSyntax
+	}
+	return uintBetweenValidator{vals[0], vals[1]}, nil
+}
+
+type uintGtValidator struct {
+	gt uint64
+}
+
+func (v uintGtValidator) validate(i reflect.Value) error {
+	val := i.Uint()
+	if val <= v.gt {
+		return fmt.Errorf("%d is not greater than %d", val, v.gt)
+	}
+	return nil
+}
+
+func newUintGtValidator(s string) (fieldValidator, error) {
+	val, err := parseUint(s)
+	if err != nil {
+		return nil, err
+	}
+	return uintGtValidator{val}, nil
+}
+
+type uintLtValidator struct {
+	lt uint64
+}
+
+func (v uintLtValidator) validate(i reflect.Value) error {
+	val := i.Uint()
+	if val >= v.lt {
+		return fmt.Errorf("%d is not less than %d", val, v.lt)
+	}
+	return nil
+}
+
+func newUintLtValidator(s string) (fieldValidator, error) {
+	val, err := parseUint(s)
+	if err != nil {
+		return nil, err
+	}
+	return uintLtValidator{val}, nil
+}
+
+type uintMultipleOfValidator struct {
+	n uint64
+}
+
+func (v uintMultipleOfValidator) validate(i reflect.Value) error {
+	val := i.Uint()
+	if val%v.n != 0 {
+		return fmt.Errorf("%d is not a multiple of %d", val, v.n)
+	}
+	return nil
+}
+
+func newUintMultipleOfValidator(s string) (fieldValidator, error) {
+	val, err := parseUint(s)
+	if err != nil {
+		return nil, err
+	}
+	if val == 0 {
+		return nil, ErrInvalidValidatorSyntax
+	}
+	return uintMultipleOfValidator{val}, nil
+}
+
+type floatMinValidator struct {
+	min float64
+}
+
+func (v floatMinValidator) validate(f reflect.Value) error {
+	val := f.Float()
+	if val < v.min {
+		return fmt.Errorf("%g is less than min allowed %g", val, v.min)
+	}
+	return nil
 }
 
-type fieldValidator interface {
-	validate(reflect.Value) error
+func newFloatMinValidator(s string) (fieldValidator, error) {
+	val, err := parseFloat(s)
+	if err != nil {
+		return nil, err
+	}
+	return floatMinValidator{val}, nil
 }
 
-type fieldValidatorCreator func(string) (fieldValidator, error)
+type floatMaxValidator struct {
+	max float64
+}
 
-type intMinValidator struct {
-	min int
+func (v floatMaxValidator) validate(f reflect.Value) error {
+	val := f.Float()
+	if val > v.max {
+		return fmt.Errorf("%g is higher than max allowed %g", val, v.max)
+	}
+	return nil
 }
 
-func (v intMinValidator) validate(i reflect.Value) error {
-	val := int(i.Int())
-	if val < v.min {
-		return fmt.Errorf("%d is less than min allowed %d", val, v.min)
+func newFloatMaxValidator(s string) (fieldValidator, error) {
+	val, err := parseFloat(s)
+	if err != nil {
+		return nil, err
+	}
+	return floatMaxValidator{val}, nil
+}
+
+type floatInValidator struct {
+	in []float64
+}
+
+func (v floatInValidator) validate(f reflect.Value) error {
+	val := f.Float()
+	if !contains(v.in, val) {
+		return fmt.Errorf("%g is not in %v", val, v.in)
 	}
 	return nil
 }
 
-func newIntMinValidator(s string) (fieldValidator, error) {
-	val, err := strconv.Atoi(s)
+func newFloatInValidator(s string) (fieldValidator, error) {
+	vals, err := parseFloatSlice(s)
 	if err != nil {
 		return nil, err
 	}
-	return intMinValidator{val}, nil
+	return floatInValidator{vals}, nil
 }
 
-type intMaxValidator struct {
-	max int
+type floatBetweenValidator struct {
+	min, max float64
 }
 
-func (v intMaxValidator) validate(i reflect.Value) error {
-	val := int(i.Int())
-	if val > v.max {
-		return fmt.Errorf("%d is higher than max allowed %d", val, v.max)
+func (v floatBetweenValidator) validate(f reflect.Value) error {
+	val := f.Float()
+	if !(val >= v.min && val <= v.max) {
+		return fmt.Errorf("%g is not between %g and %g", val, v.min, v.max)
 	}
 	return nil
 }
 
-func newIntMaxValidator(s string) (fieldValidator, error) {
-	val, err := strconv.Atoi(s)
+func newFloatBetweenValidator(s string) (fieldValidator, error) {
+	vals, err := parseFloatSlice(s)
 	if err != nil {
 		return nil, err
 	}
-	return intMaxValidator{val}, nil
+	if len(vals) != 2 || !(vals[0] <= vals[1]) {
+		return nil, ErrInvalidValidatorSyntax
+	}
+	return floatBetweenValidator{vals[0], vals[1]}, nil
 }
 
-type intInValidator struct {
-	in []int
+type floatGtValidator struct {
+	gt float64
 }
 
-func (v intInValidator) validate(i reflect.Value) error {
-	val := int(i.Int())
+func (v floatGtValidator) validate(i reflect.Value) error {
+	val := i.Float()
+	if !(val > v.gt) {
+		return fmt.Errorf("%g is not greater than %g", val, v.gt)
+	}
+	return nil
+}
+
+func newFloatGtValidator(s string) (fieldValidator, error) {
+	val, err := parseFloat(s)
+	if err != nil {
+		return nil, err
+	}
+	return floatGtValidator{val}, nil
+}
+
+type floatLtValidator struct {
+	lt float64
+}
+
+func (v floatLtValidator) validate(i reflect.Value) error {
+	val := i.Float()
+	if !(val < v.lt) {
+		return fmt.Errorf("%g is not less than %g", val, v.lt)
+	}
+	return nil
+}
+
+func newFloatLtValidator(s string) (fieldValidator, error) {
+	val, err := parseFloat(s)
+	if err != nil {
+		return nil, err
+	}
+	return floatLtValidator{val}, nil
+}
+
+type signValidator struct {
+	name string
+	ok   func(float64) bool
+}
+
+func (v signValidator) validate(n reflect.Value) error {
+	var val float64
+	switch n.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		val = float64(n.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		val = float64(n.Uint())
+	default:
+		val = n.Float()
+	}
+	if !v.ok(val) {
+		return fmt.Errorf("must be %s, got %v", v.name, n)
+	}
+	return nil
+}
+
+func newPositiveValidator(s string) (fieldValidator, error) {
+	if len(s) != 0 {
+		return nil, ErrInvalidValidatorSyntax
+	}
+	return signValidator{"positive", func(f float64) bool { return f > 0 }}, nil
+}
+
+func newNegativeValidator(s string) (fieldValidator, error) {
+	if len(s) != 0 {
+		return nil, ErrInvalidValidatorSyntax
+	}
+	return signValidator{"negative", func(f float64) bool { return f < 0 }}, nil
+}
+
+func newNonNegativeValidator(s string) (fieldValidator, error) {
+	if len(s) != 0 {
+		return nil, ErrInvalidValidatorSyntax
+	}
+	return signValidator{"nonnegative", func(f float64) bool { return f >= 0 }}, nil
+}
+
+type boolEqValidator struct {
+	eq bool
+}
+
+func (v boolEqValidator) validate(b reflect.Value) error {
+	val := b.Bool()
+	if val != v.eq {
+		return fmt.Errorf("%t is not equal to %t", val, v.eq)
+	}
+	return nil
+}
+
+func newBoolEqValidator(s string) (fieldValidator, error) {
+	val, err := parseBool(s)
+	if err != nil {
+		return nil, err
+	}
+	return boolEqValidator{val}, nil
+}
+
+type boolInValidator struct {
+	in []bool
+}
+
+func (v boolInValidator) validate(b reflect.Value) error {
+	val := b.Bool()
 	if !contains(v.in, val) {
-		return fmt.Errorf("%d is not in %v", val, v.in)
+		return fmt.Errorf("%t is not in %v", val, v.in)
 	}
 	return nil
 }
 
-func newIntInValidator(s string) (fieldValidator, error) {
-	vals, err := parseIntSlice(s)
+func newBoolInValidator(s string) (fieldValidator, error) {
+	vals, err := parseBoolSlice(s)
 	if err != nil {
 		return nil, err
 	}
-	return intInValidator{vals}, nil
+	return boolInValidator{vals}, nil
 }
 
 type strLenValidator struct {
@@ -155,18 +883,342 @@ func newStrMaxValidator(s string) (fieldValidator, error) {
 	return strMaxValidator{val}, nil
 }
 
+type strBetweenValidator struct {
+	min, max int
+}
+
+func (v strBetweenValidator) validate(s reflect.Value) error {
+	val := s.String()
+	if len(val) < v.min || len(val) > v.max {
+		return fmt.Errorf("len of %s is not between %d and %d", val, v.min, v.max)
+	}
+	return nil
+}
+
+func newStrBetweenValidator(s string) (fieldValidator, error) {
+	vals, err := parseIntSlice(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(vals) != 2 || vals[0] < 0 || vals[0] > vals[1] {
+		return nil, ErrInvalidValidatorSyntax
+	}
+	return strBetweenValidator{int(vals[0]), int(vals[1])}, nil
+}
+
 type strInValidator struct {
 	in []string
+	ci bool
 }
 
 func (v strInValidator) validate(s reflect.Value) error {
 	val := s.String()
-	if !contains(v.in, val) {
-		return fmt.Errorf("%s is not in %v", val, v.in)
+	for _, allowed := range v.in {
+		if val == allowed || v.ci && strings.EqualFold(val, allowed) {
+			return nil
+		}
 	}
-	return nil
+	return fmt.Errorf("%s is not in %v", val, v.in)
 }
 
 func newStrInValidator(s string) (fieldValidator, error) {
-	return strInValidator{strings.Split(s, ",")}, nil
+	if len(s) == 0 {
+		return nil, ErrInvalidValidatorSyntax
+	}
+	return strInValidator{in: parseStrSlice(s)}, nil
+}
+
+func newStrInCIValidator(s string) (fieldValidator, error) {
+	if len(s) == 0 {
+		return nil, ErrInvalidValidatorSyntax
+	}
+	return strInValidator{in: parseStrSlice(s), ci: true}, nil
+}
+
+type strEqValidator struct {
+	eq string
+}
+
+func (v strEqValidator) validate(s reflect.Value) error {
+	if val := s.String(); val != v.eq {
+		return fmt.Errorf("%q is not equal to %q", val, v.eq)
+	}
+	return nil
+}
+
+func newStrEqValidator(s string) (fieldValidator, error) {
+	return strEqValidator{s}, nil
+}
+
+type strNeValidator struct {
+	ne string
+}
+
+func (v strNeValidator) validate(s reflect.Value) error {
+	if val := s.String(); val == v.ne {
+		return fmt.Errorf("%q is not allowed", val)
+	}
+	return nil
+}
+
+func newStrNeValidator(s string) (fieldValidator, error) {
+	return strNeValidator{s}, nil
+}
+
+type strNotInValidator struct {
+	notIn []string
+	ci    bool
+}
+
+func (v strNotInValidator) validate(s reflect.Value) error {
+	val := s.String()
+	for _, forbidden := range v.notIn {
+		if val == forbidden || v.ci && strings.EqualFold(val, forbidden) {
+			return fmt.Errorf("%s matches forbidden value %s", val, forbidden)
+		}
+	}
+	return nil
+}
+
+func newStrNotInValidator(s string) (fieldValidator, error) {
+	if len(s) == 0 {
+		return nil, ErrInvalidValidatorSyntax
+	}
+	return strNotInValidator{notIn: parseStrSlice(s)}, nil
+}
+
+func newStrNotInCIValidator(s string) (fieldValidator, error) {
+	if len(s) == 0 {
+		return nil, ErrInvalidValidatorSyntax
+	}
+	return strNotInValidator{notIn: parseStrSlice(s), ci: true}, nil
+}
+
+type lenItemsValidator struct {
+	l int
+}
+
+func (v lenItemsValidator) validate(c reflect.Value) error {
+	if c.Len() != v.l {
+		return fmt.Errorf("number of items %d is not equal to %d", c.Len(), v.l)
+	}
+	return nil
+}
+
+func newLenItemsValidator(s string) (fieldValidator, error) {
+	val, err := strconv.Atoi(s)
+	if err != nil {
+		return nil, err
+	}
+	return lenItemsValidator{val}, nil
+}
+
+type minItemsValidator struct {
+	min int
+}
+
+func (v minItemsValidator) validate(c reflect.Value) error {
+	if c.Len() < v.min {
+		return fmt.Errorf("number of items %d is less than min allowed %d", c.Len(), v.min)
+	}
+	return nil
+}
+
+func newMinItemsValidator(s string) (fieldValidator, error) {
+	val, err := strconv.Atoi(s)
+	if err != nil {
+		return nil, err
+	}
+	return minItemsValidator{val}, nil
+}
+
+type maxItemsValidator struct {
+	max int
+}
+
+func (v maxItemsValidator) validate(c reflect.Value) error {
+	if c.Len() > v.max {
+		return fmt.Errorf("number of items %d is higher than max allowed %d", c.Len(), v.max)
+	}
+	return nil
+}
+
+func newMaxItemsValidator(s string) (fieldValidator, error) {
+	val, err := strconv.Atoi(s)
+	if err != nil {
+		return nil, err
+	}
+	return maxItemsValidator{val}, nil
+}
+
+var errOmitEmpty = errors.New("empty value omitted")
+
+type omitEmptyValidator struct{}
+
+func (v omitEmptyValidator) validate(f reflect.Value) error {
+	if f.IsZero() {
+		return errOmitEmpty
+	}
+	return nil
+}
+
+type requiredValidator struct{}
+
+func (v requiredValidator) validate(i reflect.Value) error {
+	if i.IsZero() {
+		return errors.New("is required")
+	}
+	return nil
+}
+
+func newRequiredValidator(s string) (fieldValidator, error) {
+	if len(s) != 0 {
+		return nil, ErrInvalidValidatorSyntax
+	}
+	return requiredValidator{}, nil
+}
+
+type ptrValidator struct {
+	validator fieldValidator
+}
+
+func (v ptrValidator) validate(p reflect.Value) error {
+	return v.validateCtx(context.Background(), p)
+}
+
+func (v ptrValidator) validateCtx(ctx context.Context, p reflect.Value) error {
+	if p.IsNil() {
+		return nil
+	}
+	return validateWithCtx(ctx, v.validator, p.Elem())
+}
+
+type nullValidator struct {
+	valueField string
+	validator  fieldValidator
+}
+
+func (v nullValidator) validate(n reflect.Value) error {
+	return v.validateCtx(context.Background(), n)
+}
+
+func (v nullValidator) validateCtx(ctx context.Context, n reflect.Value) error {
+	if !n.FieldByName("Valid").Bool() {
+		return nil
+	}
+	return validateWithCtx(ctx, v.validator, n.FieldByName(v.valueField))
+}
+
+type nullRequiredValidator struct{}
+
+func (v nullRequiredValidator) validate(n reflect.Value) error {
+	if !n.FieldByName("Valid").Bool() {
+		return errors.New("is required")
+	}
+	return nil
+}
+
+func newNullRequiredValidator(s string) (fieldValidator, error) {
+	if len(s) != 0 {
+		return nil, ErrInvalidValidatorSyntax
+	}
+	return nullRequiredValidator{}, nil
+}
+
+type sliceValidator struct {
+	validator fieldValidator
+}
+
+func (v sliceValidator) validate(s reflect.Value) error {
+	return v.validateCtx(context.Background(), s)
+}
+
+func (v sliceValidator) validateCtx(ctx context.Context, s reflect.Value) error {
+	errs := make(ValidationErrors, 0)
+	for i := 0; i < s.Len() && !elemDone(ctx, len(errs)); i++ {
+		if err := validateWithCtx(ctx, v.validator, s.Index(i)); err != nil {
+			errs = append(errs, nestError(fmt.Sprintf("[%d]", i), err))
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+type mapValidator struct {
+	validator fieldValidator
+}
+
+func (v mapValidator) validate(m reflect.Value) error {
+	return v.validateCtx(context.Background(), m)
+}
+
+func (v mapValidator) validateCtx(ctx context.Context, m reflect.Value) error {
+	errs := make(ValidationErrors, 0)
+	for _, key := range sortedKeys(m) {
+		if elemDone(ctx, len(errs)) {
+			break
+		}
+		if err := validateWithCtx(ctx, v.validator, m.MapIndex(key)); err != nil {
+			errs = append(errs, nestError(keyIndex(key), err))
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+type diveValidator struct {
+	validators []fieldValidator
+}
+
+func (v diveValidator) validate(c reflect.Value) error {
+	return v.validateCtx(context.Background(), c)
+}
+
+func (v diveValidator) validateCtx(ctx context.Context, c reflect.Value) error {
+	errs := make(ValidationErrors, 0)
+	if c.Kind() == reflect.Map {
+		for _, key := range sortedKeys(c) {
+			if elemDone(ctx, len(errs)) {
+				break
+			}
+			for _, err := range applyValidators(ctx, v.validators, c.MapIndex(key)) {
+				errs = append(errs, nestError(keyIndex(key), err))
+			}
+		}
+	} else {
+		for i := 0; i < c.Len() && !elemDone(ctx, len(errs)); i++ {
+			for _, err := range applyValidators(ctx, v.validators, c.Index(i)) {
+				errs = append(errs, nestError(fmt.Sprintf("[%d]", i), err))
+			}
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+func applyValidators(ctx context.Context, validators []fieldValidator, v reflect.Value) []error {
+	var errs []error
+	for _, validator := range validators {
+		err := validateWithCtx(ctx, validator, v)
+		if err == errOmitEmpty {
+			break
+		}
+		if elemErrs, ok := err.(ValidationErrors); ok {
+			for _, err := range elemErrs {
+				errs = append(errs, err)
+			}
+		} else if err != nil {
+			errs = append(errs, err)
+		}
+		if elemDone(ctx, len(errs)) {
+			break
+		}
+	}
+	return errs
 }
@@ -1,30 +1,133 @@
 package validator
 
 import (
+	"context"
+	"database/sql"
+	"encoding/json"
 	"fmt"
 	"github.com/pkg/errors"
-	"log"
+	"io/fs"
 	"reflect"
 	"strings"
+	"sync"
+	"time"
+	"unsafe"
 )
 
 var ErrNotStruct = errors.New("wrong argument given, should be a struct")
 var ErrInvalidValidatorSyntax = errors.New("invalid validator syntax")
 var ErrValidateForUnexportedFields = errors.New("validation for unexported field is not allowed")
+var ErrNilPointer = errors.New("nil pointer given, should be a pointer to struct")
+var ErrUnsupportedType = errors.New("unsupported type given")
+var ErrUnknownRule = errors.New("unknown rule")
+var ErrRuleNotApplicable = errors.New("rule is not applicable to field type")
 
 type ValidationError struct {
 	Field string
+	Rule  string
+	Param string
+	Value string
 	Err   error
+
+	message string
 }
 
 func (v ValidationError) Error() string {
+	if path, leaf := v.leaf(); len(leaf.message) != 0 {
+		return leaf.render(path)
+	}
+	if len(v.Field) == 0 {
+		return v.Err.Error()
+	}
 	return fmt.Sprintf("%s: %s", v.Field, v.Err)
 }
 
+func (v ValidationError) Unwrap() error {
+	return v.Err
+}
+
+func nestError(field string, err error) ValidationError {
+	inner, ok := err.(ValidationError)
+	if !ok {
+		return ValidationError{Field: field, Err: err}
+	}
+	inner.Field = joinField(field, inner.Field)
+	return inner
+}
+
+type jsonValidationError struct {
+	Field string `json:"field"`
+	Rule  string `json:"rule,omitempty"`
+	Param string `json:"param,omitempty"`
+	Error string `json:"error"`
+}
+
+func (v ValidationError) MarshalJSON() ([]byte, error) {
+	field, msg := v.detail()
+	return json.Marshal(jsonValidationError{field, v.Rule, v.Param, msg})
+}
+
+func (v ValidationError) detail() (string, string) {
+	field, leaf := v.leaf()
+	if len(leaf.message) != 0 {
+		return field, leaf.render(field)
+	}
+	if leaf.Err != nil {
+		return field, leaf.Err.Error()
+	}
+	return field, ""
+}
+
+func (v ValidationError) leaf() (string, ValidationError) {
+	field := v.Field
+	for {
+		inner, ok := v.Err.(ValidationError)
+		if !ok {
+			return field, v
+		}
+		field, v = joinField(field, inner.Field), inner
+	}
+}
+
+func joinField(parent, field string) string {
+	switch {
+	case len(parent) == 0:
+		return field
+	case len(field) == 0:
+		return parent
+	case strings.HasPrefix(field, "["):
+		return parent + field
+	}
+	return parent + "." + field
+}
+
+func keyIndex(key reflect.Value) string {
+	if key.Kind() == reflect.String && strings.ContainsAny(key.String(), "[]\"") {
+		return fmt.Sprintf("[%q]", key.String())
+	}
+	return fmt.Sprintf("[%v]", key)
+}
+
 type ValidationErrors []ValidationError
 
+func (v ValidationErrors) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		v = ValidationErrors{}
+	}
+	return json.Marshal([]ValidationError(v))
+}
+
+type Validatable interface {
+	ValidateStruct() error
+}
+
+var validatableType = reflect.TypeOf((*Validatable)(nil)).Elem()
+
 func (v ValidationErrors) Error() string {
 	if len(v) == 1 {
+		if _, leaf := v[0].leaf(); len(leaf.message) != 0 {
+			return v[0].Error()
+		}
 		return v[0].Err.Error()
 	}
 	s := make([]string, 0, len(v))
@@ -34,108 +137,1078 @@ func (v ValidationErrors) Error() string {
 	return strings.Join(s, "\n")
 }
 
+func (v ValidationErrors) Fields() []string {
+	fields := make([]string, 0, len(v))
+	seen := make(map[string]bool, len(v))
+	for _, err := range v {
+		if !seen[err.Field] {
+			seen[err.Field] = true
+			fields = append(fields, err.Field)
+		}
+	}
+	return fields
+}
+
+func (v ValidationErrors) ByField(name string) []ValidationError {
+	var errs []ValidationError
+	for _, err := range v {
+		if err.Field == name {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+func (v ValidationErrors) ByFieldPrefix(prefix string) []ValidationError {
+	var errs []ValidationError
+	for _, err := range v {
+		if hasFieldPrefix(err.Field, prefix) {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+func hasFieldPrefix(field, prefix string) bool {
+	if !strings.HasPrefix(field, prefix) {
+		return false
+	}
+	rest := field[len(prefix):]
+	return len(rest) == 0 || len(prefix) == 0 || rest[0] == '.' || rest[0] == '['
+}
+
+func (v ValidationErrors) Has(name string) bool {
+	for _, err := range v {
+		if err.Field == name {
+			return true
+		}
+	}
+	return false
+}
+
 var intValidators = map[string]fieldValidatorCreator{
-	"min": newIntMinValidator,
-	"max": newIntMaxValidator,
-	"in":  newIntInValidator,
+	"min":         newIntMinValidator,
+	"max":         newIntMaxValidator,
+	"in":          newIntInValidator,
+	"port":        newPortValidator,
+	"between":     newIntBetweenValidator,
+	"ne":          newIntNeValidator,
+	"eq":          newIntEqValidator,
+	"gt":          newIntGtValidator,
+	"gte":         newIntMinValidator,
+	"lt":          newIntLtValidator,
+	"lte":         newIntMaxValidator,
+	"multipleof":  newIntMultipleOfValidator,
+	"positive":    newPositiveValidator,
+	"negative":    newNegativeValidator,
+	"nonnegative": newNonNegativeValidator,
+	"not_in":      newIntNotInValidator,
+	"required":    newRequiredValidator,
+}
+
+var uintValidators = map[string]fieldValidatorCreator{
+	"min":         newUintMinValidator,
+	"max":         newUintMaxValidator,
+	"in":          newUintInValidator,
+	"port":        newPortValidator,
+	"between":     newUintBetweenValidator,
+	"ne":          newUintNeValidator,
+	"eq":          newUintEqValidator,
+	"gt":          newUintGtValidator,
+	"gte":         newUintMinValidator,
+	"lt":          newUintLtValidator,
+	"lte":         newUintMaxValidator,
+	"multipleof":  newUintMultipleOfValidator,
+	"positive":    newPositiveValidator,
+	"nonnegative": newNonNegativeValidator,
+	"not_in":      newUintNotInValidator,
+	"required":    newRequiredValidator,
+}
+
+var floatValidators = map[string]fieldValidatorCreator{
+	"min":         newFloatMinValidator,
+	"max":         newFloatMaxValidator,
+	"in":          newFloatInValidator,
+	"latitude":    newLatitudeValidator,
+	"longitude":   newLongitudeValidator,
+	"between":     newFloatBetweenValidator,
+	"gt":          newFloatGtValidator,
+	"gte":         newFloatMinValidator,
+	"lt":          newFloatLtValidator,
+	"lte":         newFloatMaxValidator,
+	"positive":    newPositiveValidator,
+	"negative":    newNegativeValidator,
+	"nonnegative": newNonNegativeValidator,
+	"required":    newRequiredValidator,
+}
+
+var boolValidators = map[string]fieldValidatorCreator{
+	"eq":       newBoolEqValidator,
+	"in":       newBoolInValidator,
+	"required": newRequiredValidator,
 }
 
 var strValidators = map[string]fieldValidatorCreator{
-	"len": newStrLenValidator,
-	"min": newStrMinValidator,
-	"max": newStrMaxValidator,
-	"in":  newStrInValidator,
+	"len":           newStrLenValidator,
+	"min":           newStrMinValidator,
+	"max":           newStrMaxValidator,
+	"in":            newStrInValidator,
+	"in_ci":         newStrInCIValidator,
+	"json":          newJSONValidator,
+	"regexp":        newRegexpValidator,
+	"url":           newURLValidator,
+	"uuid":          newUUIDValidator,
+	"ip":            newIPValidator,
+	"ipv4":          newIPv4Validator,
+	"ipv6":          newIPv6Validator,
+	"hostname":      newHostnameValidator,
+	"port":          newPortValidator,
+	"alpha":         newAlphaValidator,
+	"alphanum":      newAlphaNumValidator,
+	"numeric":       newNumericValidator,
+	"lowercase":     newLowercaseValidator,
+	"uppercase":     newUppercaseValidator,
+	"ascii":         newASCIIValidator,
+	"printable":     newPrintableValidator,
+	"contains":      newContainsValidator,
+	"contains_ci":   newContainsCIValidator,
+	"prefix":        newPrefixValidator,
+	"startswith":    newPrefixValidator,
+	"suffix":        newSuffixValidator,
+	"endswith":      newSuffixValidator,
+	"not_in":        newStrNotInValidator,
+	"not_in_ci":     newStrNotInCIValidator,
+	"base64":        newBase64Validator,
+	"hex":           newHexValidator,
+	"datetime":      newDatetimeValidator,
+	"semver":        newSemverValidator,
+	"e164":          newE164Validator,
+	"creditcard":    newCreditCardValidator,
+	"runelen":       newRuneLenValidator,
+	"runemin":       newRuneMinValidator,
+	"runemax":       newRuneMaxValidator,
+	"no_whitespace": newNoWhitespaceValidator,
+	"single_line":   newSingleLineValidator,
+	"slug":          newSlugValidator,
+	"isbn":          newISBNValidator,
+	"country_code":  newCountryCodeValidator,
+	"currency_code": newCurrencyCodeValidator,
+	"timezone":      newTimezoneValidator,
+	"latitude":      newLatitudeValidator,
+	"longitude":     newLongitudeValidator,
+	"cidr":          newCIDRValidator,
+	"fqdn":          newFQDNValidator,
+	"jwt":           newJWTValidator,
+	"hexcolor":      newHexColorValidator,
+	"color":         newColorValidator,
+	"file":          newFileValidator,
+	"dir":           newDirValidator,
+	"eq":            newStrEqValidator,
+	"ne":            newStrNeValidator,
+	"between":       newStrBetweenValidator,
+	"required":      newRequiredValidator,
 }
 
-func parseValidators(t reflect.Type, tag string) ([]fieldValidator, error) {
-	var fieldValidators map[string]fieldValidatorCreator
-	switch t.Kind() {
-	case reflect.Int:
-		fieldValidators = intValidators
-	case reflect.String:
-		fieldValidators = strValidators
-	default:
-		log.Panicf("unsupported type: %s", t.String())
+var bytesValidators = map[string]fieldValidatorCreator{
+	"len":      newBytesLenValidator,
+	"min":      newBytesMinValidator,
+	"max":      newBytesMaxValidator,
+	"hex":      newHexValidator,
+	"base64":   newBase64Validator,
+	"json":     newJSONValidator,
+	"required": newRequiredValidator,
+}
+
+var collectionValidators = map[string]fieldValidatorCreator{
+	"lenitems": newLenItemsValidator,
+	"minitems": newMinItemsValidator,
+	"maxitems": newMaxItemsValidator,
+	"required": newRequiredValidator,
+}
+
+var timeValidators = map[string]fieldValidatorCreator{
+	"after":    newTimeAfterValidator,
+	"before":   newTimeBeforeValidator,
+	"min":      newTimeMinValidator,
+	"max":      newTimeMaxValidator,
+	"required": newTimeRequiredValidator,
+}
+
+var durationValidators = map[string]fieldValidatorCreator{
+	"min":      newDurationMinValidator,
+	"max":      newDurationMaxValidator,
+	"in":       newDurationInValidator,
+	"required": newRequiredValidator,
+}
+
+var nullTypes = map[reflect.Type]string{
+	reflect.TypeOf(sql.NullString{}):  "String",
+	reflect.TypeOf(sql.NullInt64{}):   "Int64",
+	reflect.TypeOf(sql.NullInt32{}):   "Int32",
+	reflect.TypeOf(sql.NullInt16{}):   "Int16",
+	reflect.TypeOf(sql.NullByte{}):    "Byte",
+	reflect.TypeOf(sql.NullFloat64{}): "Float64",
+	reflect.TypeOf(sql.NullBool{}):    "Bool",
+	reflect.TypeOf(sql.NullTime{}):    "Time",
+}
+
+var nullValidators = map[string]fieldValidatorCreator{
+	"required": newNullRequiredValidator,
+}
+
+var ptrValidators = map[string]fieldValidatorCreator{
+	"required": newRequiredValidator,
+}
+
+var crossFieldValidators = map[string]crossFieldValidatorCreator{
+	"eqfield":          newEqFieldValidator,
+	"nefield":          newNeFieldValidator,
+	"gtfield":          newGtFieldValidator,
+	"ltfield":          newLtFieldValidator,
+	"required_if":      newRequiredIfValidator,
+	"required_with":    newRequiredWithValidator,
+	"required_without": newRequiredWithoutValidator,
+	"excluded_with":    newExcludedWithValidator,
+}
+
+type parsedTag struct {
+	validators []fieldValidator
+	err        error
+}
+
+type tagKey struct {
+	parent reflect.Type
+	index  int
+	t      reflect.Type
+	tag    string
+}
+
+func (val *Validator) parseValidators(parent reflect.Type, index int, t reflect.Type, tag string) ([]fieldValidator, error) {
+	key := tagKey{parent, index, t, tag}
+	if parsed, ok := val.parsedTags.Load(key); ok {
+		return parsed.(parsedTag).validators, parsed.(parsedTag).err
+	}
+	val.mu.RLock()
+	generation := val.generation
+	val.mu.RUnlock()
+	validators, err := val.parseTag(parent, index, t, tag)
+	val.mu.RLock()
+	if val.generation == generation {
+		val.parsedTags.Store(key, parsedTag{validators, err})
+	}
+	val.mu.RUnlock()
+	return validators, err
+}
+
+var emptyParamRules = map[string]bool{
+	"eq": true,
+	"ne": true,
+}
+
+// Rule is a single name:param pair of a validate tag as returned by ParseRules.
+// Its fields are part of the stable API: new fields may be added, existing ones
+// will not be renamed or change meaning.
+type Rule struct {
+	Name  string
+	Param string
+}
+
+func (r Rule) String() string {
+	if len(r.Param) == 0 && !emptyParamRules[r.Name] {
+		return r.Name
 	}
+	return r.Name + ":" + r.Param
+}
+
+func (r Rule) Params() []string {
+	return parseStrSlice(r.Param)
+}
+
+type RuleError struct {
+	Rule Rule
+	Err  error
+}
+
+func (e RuleError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Rule, e.Err)
+}
 
-	kvs := strings.Split(tag, ";")
-	validators := make([]fieldValidator, 0, len(kvs))
+func (e RuleError) Unwrap() error {
+	return e.Err
+}
+
+func (e RuleError) Is(target error) bool {
+	return target == ErrInvalidValidatorSyntax
+}
+
+func (val *Validator) parseTag(parent reflect.Type, index int, t reflect.Type, tag string) ([]fieldValidator, error) {
+	rules, err := ParseRules(tag)
+	if err != nil {
+		return nil, err
+	}
+	validators, err := val.parseRules(parent, index, t, rules)
+	if err != nil {
+		return nil, ErrInvalidValidatorSyntax
+	}
+	return validators, nil
+}
+
+func CheckTag(parent reflect.Type, index int, tag string) error {
+	return defaultValidator.CheckTag(parent, index, tag)
+}
+
+func (val *Validator) CheckTag(parent reflect.Type, index int, tag string) error {
+	if parent == nil || parent.Kind() != reflect.Struct || index < 0 || index >= parent.NumField() {
+		return ErrUnsupportedType
+	}
+	rules, err := ParseRules(tag)
+	if err != nil {
+		return err
+	}
+	f := parent.Field(index)
+	if !f.IsExported() {
+		return ErrValidateForUnexportedFields
+	}
+	t := f.Type
+	if !isTaggable(t) {
+		return RuleError{rules[0], ErrRuleNotApplicable}
+	}
+	_, err = val.parseRules(parent, index, t, rules)
+	return err
+}
+
+func (val *Validator) ruleError(rule Rule, err error) error {
+	if _, ok := err.(RuleError); ok {
+		return err
+	}
+	if err != ErrRuleNotApplicable {
+		return RuleError{rule, ErrInvalidValidatorSyntax}
+	}
+	if _, ok := val.customRule(rule.Name); !ok && !isBuiltinRule(rule.Name) {
+		return RuleError{rule, ErrUnknownRule}
+	}
+	return RuleError{rule, err}
+}
+
+func ParseRules(tag string) ([]Rule, error) {
+	kvs := splitEscaped(tag, ';')
+	rules := make([]Rule, 0, len(kvs))
 	for _, kv := range kvs {
-		vals := strings.Split(kv, ":")
-		if len(vals) != 2 || len(vals[0]) == 0 || len(vals[1]) == 0 {
+		k, v, found := strings.Cut(kv, ":")
+		if len(k) == 0 || found && len(v) == 0 && !emptyParamRules[k] {
 			return nil, ErrInvalidValidatorSyntax
 		}
-		k, v := vals[0], vals[1]
-		createValidator, ok := fieldValidators[k]
-		if !ok {
-			return nil, ErrInvalidValidatorSyntax
+		rules = append(rules, Rule{k, v})
+	}
+	return rules, nil
+}
+
+var collectionAliases = map[string]string{
+	"len": "lenitems",
+	"min": "minitems",
+	"max": "maxitems",
+}
+
+func (val *Validator) parseRules(parent reflect.Type, index int, t reflect.Type, rules []Rule) ([]fieldValidator, error) {
+	var elemRules []Rule
+	dive := false
+	for i, rule := range rules {
+		if rule.Name == "dive" {
+			if len(rule.Param) != 0 {
+				return nil, RuleError{rule, ErrInvalidValidatorSyntax}
+			}
+			rules, elemRules, dive = rules[:i], rules[i+1:], true
+			break
+		}
+	}
+	validators := make([]fieldValidator, 0, len(rules)+1)
+	var omitEmpty, required, hasDefault bool
+	for _, rule := range rules {
+		k, v := rule.Name, rule.Param
+		if len(k) == 0 {
+			return nil, RuleError{rule, ErrInvalidValidatorSyntax}
+		}
+		if k == "omitempty" {
+			if len(v) != 0 || omitEmpty {
+				return nil, RuleError{rule, ErrInvalidValidatorSyntax}
+			}
+			omitEmpty = true
+			continue
+		}
+		required = required || k == "required"
+		var validator fieldValidator
+		var err error
+		if k == "default" {
+			if hasDefault {
+				return nil, RuleError{rule, ErrInvalidValidatorSyntax}
+			}
+			hasDefault = true
+			validator, err = newDefaultValueValidator(t, v)
+		} else if custom, ok := val.customRule(k); ok {
+			validator, err = createCustomValidator(custom, t, k, v)
+		} else if create, ok := crossFieldValidators[k]; ok {
+			validator, err = newCrossFieldRuleValidator(parent, index, k, v, create)
+		} else if dive {
+			validator, err = createCollectionValidator(t, k, v)
+		} else {
+			validator, err = createValidator(t, k, v)
 		}
-		validator, err := createValidator(v)
 		if err != nil {
-			return nil, ErrInvalidValidatorSyntax
+			return nil, val.ruleError(rule, err)
+		}
+		validators = append(validators, validator)
+	}
+	if dive {
+		validator, err := val.createDiveValidator(t, elemRules)
+		if err != nil {
+			return nil, val.ruleError(Rule{Name: "dive"}, err)
 		}
 		validators = append(validators, validator)
 	}
+	if omitEmpty {
+		if required {
+			return nil, RuleError{Rule{Name: "omitempty"}, ErrInvalidValidatorSyntax}
+		}
+		validators = append([]fieldValidator{omitEmptyValidator{}}, validators...)
+	}
 	return validators, nil
 }
 
-func needValidation(f reflect.StructField) bool {
-	switch f.Type.Kind() {
-	case reflect.Int, reflect.String:
-		if _, ok := f.Tag.Lookup("validate"); ok {
-			return true
+func createCollectionValidator(t reflect.Type, name, param string) (fieldValidator, error) {
+	rule := name
+	if alias, ok := collectionAliases[name]; ok {
+		rule = alias
+	}
+	if t.Kind() == reflect.Ptr {
+		validator, err := createCollectionValidator(t.Elem(), name, param)
+		if err != nil {
+			return nil, err
+		}
+		return ptrValidator{validator}, nil
+	}
+	switch t.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		if create, ok := collectionValidators[rule]; ok {
+			return newRuleValidator(name, param, create)
+		}
+	}
+	return nil, ErrRuleNotApplicable
+}
+
+func (val *Validator) createDiveValidator(t reflect.Type, rules []Rule) (fieldValidator, error) {
+	if t.Kind() == reflect.Ptr {
+		validator, err := val.createDiveValidator(t.Elem(), rules)
+		if err != nil {
+			return nil, err
+		}
+		return ptrValidator{validator}, nil
+	}
+	switch t.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+	default:
+		return nil, ErrRuleNotApplicable
+	}
+	if len(rules) == 0 {
+		return nil, ErrInvalidValidatorSyntax
+	}
+	for _, rule := range rules {
+		if rule.Name == "default" {
+			return nil, RuleError{rule, ErrRuleNotApplicable}
+		}
+	}
+	validators, err := val.parseRules(nil, 0, t.Elem(), rules)
+	if err != nil {
+		return nil, err
+	}
+	return diveValidator{validators}, nil
+}
+
+var (
+	timeType     = reflect.TypeOf(time.Time{})
+	durationType = reflect.TypeOf(time.Duration(0))
+)
+
+func typeValidators(t reflect.Type) map[string]fieldValidatorCreator {
+	switch {
+	case t == durationType:
+		return durationValidators
+	case t.Kind() == reflect.Struct && t.ConvertibleTo(timeType):
+		return timeValidators
+	case t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8:
+		return bytesValidators
+	}
+	return kindValidators(t.Kind())
+}
+
+func kindValidators(k reflect.Kind) map[string]fieldValidatorCreator {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return intValidators
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return uintValidators
+	case reflect.Float32, reflect.Float64:
+		return floatValidators
+	case reflect.Bool:
+		return boolValidators
+	case reflect.String:
+		return strValidators
+	}
+	return nil
+}
+
+func createValidator(t reflect.Type, name, param string) (fieldValidator, error) {
+	if t.Kind() == reflect.Ptr {
+		if create, ok := ptrValidators[name]; ok {
+			return newRuleValidator(name, param, create)
+		}
+		validator, err := createValidator(t.Elem(), name, param)
+		if err != nil {
+			return nil, err
+		}
+		return ptrValidator{validator}, nil
+	}
+	if valueField, ok := nullTypes[t]; ok {
+		if create, ok := nullValidators[name]; ok {
+			return newRuleValidator(name, param, create)
+		}
+		f, _ := t.FieldByName(valueField)
+		validator, err := createValidator(f.Type, name, param)
+		if err != nil {
+			return nil, err
+		}
+		return nullValidator{valueField, validator}, nil
+	}
+	if fieldValidators := typeValidators(t); fieldValidators != nil {
+		create, ok := fieldValidators[name]
+		if !ok {
+			return nil, ErrRuleNotApplicable
+		}
+		return newRuleValidator(name, param, create)
+	}
+	switch t.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		rule := name
+		if alias, ok := collectionAliases[name]; ok && t.Kind() != reflect.Slice {
+			rule = alias
+		}
+		if create, ok := collectionValidators[rule]; ok {
+			return newRuleValidator(name, param, create)
+		}
+		validator, err := createValidator(t.Elem(), name, param)
+		if err != nil {
+			return nil, err
+		}
+		if t.Kind() == reflect.Map {
+			return mapValidator{validator}, nil
 		}
+		return sliceValidator{validator}, nil
+	}
+	return nil, ErrRuleNotApplicable
+}
+
+func (val *Validator) needValidation(f reflect.StructField) bool {
+	return val.fieldNeedValidation(f, make(map[reflect.Type]bool))
+}
+
+func (val *Validator) fieldNeedValidation(f reflect.StructField, visited map[reflect.Type]bool) bool {
+	if _, ok := f.Tag.Lookup(val.tagName); ok && isTaggable(f.Type) {
+		return true
+	}
+	return val.typeNeedValidation(f.Type, f.IsExported(), visited)
+}
+
+func (val *Validator) typeNeedValidation(t reflect.Type, dynamic bool, visited map[reflect.Type]bool) bool {
+	t = indirectType(t)
+	if isValidatable(t) {
+		return false
+	}
+	switch t.Kind() {
+	case reflect.Interface:
+		return dynamic
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return val.typeNeedValidation(t.Elem(), dynamic, visited)
 	case reflect.Struct:
-		for i := 0; i < f.Type.NumField(); i++ {
-			if needValidation(f.Type.Field(i)) {
-				return true
-			}
+		return val.structNeedValidation(t, visited)
+	}
+	return false
+}
+
+func isTaggable(t reflect.Type) bool {
+	if isValidatable(t) || t.Kind() == reflect.Ptr {
+		return true
+	}
+	switch indirectType(t).Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return true
+	}
+	return false
+}
+
+func isValidatable(t reflect.Type) bool {
+	t = indirectType(t)
+	if _, ok := nullTypes[t]; ok {
+		return true
+	}
+	if typeValidators(t) != nil {
+		return true
+	}
+	switch t.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return isValidatable(t.Elem())
+	}
+	return false
+}
+
+func (val *Validator) structNeedValidation(t reflect.Type, visited map[reflect.Type]bool) bool {
+	if t.Kind() != reflect.Struct || visited[t] {
+		return false
+	}
+	visited[t] = true
+	if hasStructValidation(t) {
+		return true
+	}
+	for i := 0; i < t.NumField(); i++ {
+		if val.fieldNeedValidation(t.Field(i), visited) {
+			return true
 		}
 	}
 	return false
 }
 
+func indirectType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t
+}
+
+func indirect(v reflect.Value) (reflect.Value, bool) {
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return v, false
+		}
+		v = v.Elem()
+	}
+	return v, true
+}
+
+func exported(v reflect.Value) reflect.Value {
+	if v.CanInterface() || !v.CanAddr() {
+		return v
+	}
+	return reflect.NewAt(v.Type(), unsafe.Pointer(v.UnsafeAddr())).Elem()
+}
+
+func addressable(v reflect.Value) reflect.Value {
+	if v.CanAddr() {
+		return v
+	}
+	cp := reflect.New(v.Type()).Elem()
+	cp.Set(v)
+	return cp
+}
+
+func dynamicStruct(v reflect.Value) (reflect.Value, bool) {
+	if v.IsNil() {
+		return v, false
+	}
+	nested, ok := indirect(v.Elem())
+	if !ok || nested.Kind() != reflect.Struct || isValidatable(nested.Type()) {
+		return nested, false
+	}
+	return addressable(nested), true
+}
+
+type Validator struct {
+	parsedTags       sync.Map
+	mu               sync.RWMutex
+	generation       uint64
+	customRules      map[string]customRule
+	overrideBuiltins bool
+	tagName          string
+	failFast         bool
+	messages         map[string]string
+	nameTags         []string
+	stat             func(string) (fs.FileInfo, error)
+	structNames      sync.Map
+}
+
+type Option func(*Validator)
+
+func New(opts ...Option) *Validator {
+	val := &Validator{tagName: "validate"}
+	for _, opt := range opts {
+		opt(val)
+	}
+	return val
+}
+
+func WithTagName(name string) Option {
+	return func(val *Validator) {
+		val.tagName = name
+	}
+}
+
+func WithFailFast(failFast bool) Option {
+	return func(val *Validator) {
+		val.failFast = failFast
+	}
+}
+
+func WithJSONFieldNames() Option {
+	return WithNameTags("json")
+}
+
+func WithNameTags(tags ...string) Option {
+	return func(val *Validator) {
+		val.nameTags = tags
+	}
+}
+
+var defaultValidator = New()
+
 func Validate(v any) error {
-	t := reflect.TypeOf(v)
-	if t.Kind() != reflect.Struct {
+	return defaultValidator.Validate(v)
+}
+
+func (val *Validator) Validate(v any) error {
+	return val.validate(context.Background(), v, nil)
+}
+
+func ValidateCtx(ctx context.Context, v any) error {
+	return defaultValidator.ValidateCtx(ctx, v)
+}
+
+func (val *Validator) ValidateCtx(ctx context.Context, v any) error {
+	return val.validate(ctx, v, nil)
+}
+
+func (val *Validator) validate(ctx context.Context, v any, filter *fieldFilter) error {
+	if err := ctx.Err(); err != nil {
+		return errors.Wrap(err, "validation canceled")
+	}
+	vv, ok := indirect(reflect.ValueOf(v))
+	if !ok {
+		return ErrNilPointer
+	}
+	if vv.Kind() != reflect.Struct {
 		return ErrNotStruct
 	}
+	return val.validateRoot(ctx, addressable(vv), filter, nil)
+}
+
+func (val *Validator) validateRoot(ctx context.Context, vv reflect.Value, filter *fieldFilter, overrides map[int][]fieldValidator) error {
+	ctx = val.failFastContext(val.statContext(ctx))
+	ctx = context.WithValue(ctx, visitingKey{}, make(map[visit]bool))
+	errs := val.validateStruct(ctx, vv, filter, overrides)
+	if err := ctx.Err(); err != nil {
+		return errors.Wrap(err, "validation canceled")
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return val.withMessages(errs)
+}
+
+func ValidateVar(v any, tag string) error {
+	return defaultValidator.ValidateVar(v, tag)
+}
+
+func (val *Validator) ValidateVar(v any, tag string) error {
 	vv := reflect.ValueOf(v)
+	if !vv.IsValid() || !isTaggable(vv.Type()) {
+		return ErrUnsupportedType
+	}
+	validators, err := val.parseValidators(nil, 0, vv.Type(), tag)
+	if err != nil {
+		return err
+	}
+	ctx := val.failFastContext(val.statContext(context.Background()))
 	errs := make(ValidationErrors, 0)
-	for i := 0; i < t.NumField(); i++ {
-		f := t.Field(i)
-		if !needValidation(f) {
-			continue
+	for _, validator := range validators {
+		err := validateWithCtx(ctx, validator, vv)
+		if err == errOmitEmpty {
+			break
 		}
-		if !f.IsExported() {
-			errs = append(errs, ValidationError{f.Name, ErrValidateForUnexportedFields})
+		if elemErrs, ok := err.(ValidationErrors); ok {
+			errs = append(errs, elemErrs...)
+		} else if err != nil {
+			errs = append(errs, nestError("", err))
+		}
+		if val.done(ctx, errs) {
+			break
+		}
+	}
+	if errs = val.limit(ctx, errs); len(errs) == 0 {
+		return nil
+	}
+	return val.withMessages(errs)
+}
+
+type visitingKey struct{}
+
+type visit struct {
+	addr uintptr
+	typ  reflect.Type
+}
+
+func enter(visiting map[visit]bool, v reflect.Value) (visit, bool) {
+	if !v.CanAddr() {
+		return visit{}, true
+	}
+	key := visit{v.UnsafeAddr(), v.Type()}
+	if visiting[key] {
+		return key, false
+	}
+	visiting[key] = true
+	return key, true
+}
+
+func (val *Validator) validateStruct(ctx context.Context, vv reflect.Value, filter *fieldFilter, overrides map[int][]fieldValidator) ValidationErrors {
+	if visiting, ok := ctx.Value(visitingKey{}).(map[visit]bool); ok {
+		key, ok := enter(visiting, vv)
+		if !ok {
+			return nil
+		}
+		defer delete(visiting, key)
+	}
+	errs := val.validateFields(ctx, vv, filter, overrides)
+	if val.done(ctx, errs) || filter != nil {
+		return errs
+	}
+	return val.limit(ctx, append(errs, structValidation(vv)...))
+}
+
+type failFastKey struct{}
+
+func (val *Validator) isFailFast(ctx context.Context) bool {
+	return val.failFast || ctx.Value(failFastKey{}) != nil
+}
+
+func (val *Validator) failFastContext(ctx context.Context) context.Context {
+	if !val.failFast {
+		return ctx
+	}
+	return context.WithValue(ctx, failFastKey{}, true)
+}
+
+func elemDone(ctx context.Context, n int) bool {
+	return n > 0 && ctx.Value(failFastKey{}) != nil || ctx.Err() != nil
+}
+
+func (val *Validator) done(ctx context.Context, errs ValidationErrors) bool {
+	return len(errs) > 0 && val.isFailFast(ctx) || ctx.Err() != nil
+}
+
+func (val *Validator) limit(ctx context.Context, errs ValidationErrors) ValidationErrors {
+	if len(errs) > 0 && val.isFailFast(ctx) {
+		return errs[:1]
+	}
+	return errs
+}
+
+func Valid(v any) bool {
+	return defaultValidator.Valid(v)
+}
+
+func (val *Validator) Valid(v any) bool {
+	return val.validate(context.WithValue(context.Background(), failFastKey{}, true), v, nil) == nil
+}
+
+func MustValidate(v any) {
+	defaultValidator.MustValidate(v)
+}
+
+func (val *Validator) MustValidate(v any) {
+	if err := val.Validate(v); err != nil {
+		panic(err)
+	}
+}
+
+var structValidations sync.Map
+
+func RegisterStructValidation[T any](fn func(T) []ValidationError) {
+	structValidations.Store(reflect.TypeOf((*T)(nil)).Elem(), func(v reflect.Value) []ValidationError {
+		return fn(v.Interface().(T))
+	})
+}
+
+func hasStructValidation(t reflect.Type) bool {
+	if _, ok := structValidations.Load(t); ok {
+		return true
+	}
+	return reflect.PointerTo(t).Implements(validatableType)
+}
+
+func structValidation(vv reflect.Value) ValidationErrors {
+	var errs ValidationErrors
+	if fn, ok := structValidations.Load(vv.Type()); ok {
+		errs = append(errs, fn.(func(reflect.Value) []ValidationError)(vv)...)
+	}
+	if !reflect.PointerTo(vv.Type()).Implements(validatableType) {
+		return errs
+	}
+	hook := vv
+	if hook.CanAddr() {
+		hook = hook.Addr()
+	}
+	validatable, ok := hook.Interface().(Validatable)
+	if !ok {
+		return errs
+	}
+	switch err := validatable.ValidateStruct().(type) {
+	case nil:
+	case ValidationErrors:
+		errs = append(errs, err...)
+	case ValidationError:
+		errs = append(errs, err)
+	default:
+		errs = append(errs, ValidationError{Err: err})
+	}
+	return errs
+}
+
+func (val *Validator) validateFields(ctx context.Context, vv reflect.Value, filter *fieldFilter, overrides map[int][]fieldValidator) ValidationErrors {
+	t := vv.Type()
+	errs := make(ValidationErrors, 0)
+	names := val.fieldNames(t)
+	for i := 0; i < t.NumField() && !val.done(ctx, errs); i++ {
+		f := t.Field(i)
+		override, overridden := overrides[i]
+		if !overridden && !val.needValidation(f) {
 			continue
 		}
-		if f.Type.Kind() == reflect.Struct {
-			nestedErrs := Validate(vv.Field(i).Interface())
-			if nestedErrs == nil {
+		if f.Anonymous && indirectType(f.Type).Kind() == reflect.Struct && !isValidatable(f.Type) && !overridden {
+			nested, walk := filter, true
+			if filter.has(f.Name) {
+				nested, _, walk = filter.lookup(f.Name)
+			}
+			if !walk {
 				continue
 			}
-			for _, err := range nestedErrs.(ValidationErrors) {
-				errs = append(errs, ValidationError{f.Name, err})
+			embedded, ok := indirect(exported(vv.Field(i)))
+			if !ok {
+				continue
 			}
+			errs = append(errs, val.validateFields(ctx, embedded, nested, nil)...)
 			continue
 		}
-		validators, err := parseValidators(f.Type, f.Tag.Get("validate"))
-		if err != nil {
-			errs = append(errs, ValidationError{f.Name, err})
+		nested, rules, walk := filter.lookup(f.Name)
+		if !walk {
+			continue
+		}
+		name := names[i]
+		if !f.IsExported() {
+			errs = append(errs, ValidationError{Field: name, Err: ErrValidateForUnexportedFields})
 			continue
 		}
 		fv := vv.Field(i)
-		for _, validator := range validators {
-			err = validator.validate(fv)
+		if overridden {
+			errs = val.applyFieldValidators(ctx, vv, fv, name, override, errs)
+		} else if tag, ok := f.Tag.Lookup(val.tagName); ok && rules && isTaggable(f.Type) {
+			validators, err := val.parseValidators(t, i, f.Type, tag)
 			if err != nil {
-				errs = append(errs, ValidationError{f.Name, err})
+				errs = append(errs, nestError(name, err))
+				continue
 			}
+			errs = val.applyFieldValidators(ctx, vv, fv, name, validators, errs)
+		}
+		if isValidatable(f.Type) || val.done(ctx, errs) {
+			continue
+		}
+		for _, err := range val.validateValue(ctx, fv, nested) {
+			errs = append(errs, nestError(name, err))
 		}
 	}
-	if len(errs) == 0 {
-		return nil
+	return val.limit(ctx, errs)
+}
+
+func (val *Validator) applyFieldValidators(ctx context.Context, parent, fv reflect.Value, name string, validators []fieldValidator, errs ValidationErrors) ValidationErrors {
+	for _, validator := range validators {
+		var err error
+		if _, ok := validator.(crossFieldValidator); ok {
+			err = validator.validate(parent)
+		} else {
+			err = validateWithCtx(ctx, validator, fv)
+		}
+		if err == errOmitEmpty {
+			break
+		}
+		if elemErrs, ok := err.(ValidationErrors); ok {
+			for _, err := range elemErrs {
+				errs = append(errs, nestError(name, err))
+			}
+		} else if err != nil {
+			errs = append(errs, nestError(name, err))
+		}
+		if val.done(ctx, errs) {
+			break
+		}
 	}
 	return errs
 }
+
+func (val *Validator) fieldNames(t reflect.Type) []string {
+	if names, ok := val.structNames.Load(t); ok {
+		return names.([]string)
+	}
+	names := make([]string, t.NumField())
+	for i := range names {
+		names[i] = fieldName(t.Field(i), val.nameTags)
+	}
+	val.structNames.Store(t, names)
+	return names
+}
+
+func fieldName(f reflect.StructField, tags []string) string {
+	for _, key := range tags {
+		tag := f.Tag.Get(key)
+		if tag == "-" {
+			continue
+		}
+		if name, _, _ := strings.Cut(tag, ","); len(name) != 0 {
+			return name
+		}
+	}
+	return f.Name
+}
+
+func (val *Validator) validateValue(ctx context.Context, v reflect.Value, filter *fieldFilter) ValidationErrors {
+	v, ok := indirect(v)
+	if !ok {
+		return nil
+	}
+	switch v.Kind() {
+	case reflect.Interface:
+		nested, ok := dynamicStruct(v)
+		if !ok {
+			return nil
+		}
+		return val.validateStruct(ctx, nested, filter, nil)
+	case reflect.Struct:
+		if isValidatable(v.Type()) {
+			return nil
+		}
+		return val.validateStruct(ctx, v, filter, nil)
+	case reflect.Slice, reflect.Array:
+		errs := make(ValidationErrors, 0)
+		for i := 0; i < v.Len() && !val.done(ctx, errs); i++ {
+			for _, err := range val.validateValue(ctx, v.Index(i), filter) {
+				errs = append(errs, nestError(fmt.Sprintf("[%d]", i), err))
+			}
+		}
+		return errs
+	case reflect.Map:
+		errs := make(ValidationErrors, 0)
+		for _, key := range sortedKeys(v) {
+			for _, err := range val.validateValue(ctx, addressable(v.MapIndex(key)), filter) {
+				errs = append(errs, nestError(keyIndex(key), err))
+			}
+			if val.done(ctx, errs) {
+				break
+			}
+		}
+		return errs
+	}
+	return nil
+}
@@ -1,12 +1,62 @@
 package validator
 
 import (
+	"database/sql"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
 
+type (
+	userID    int64
+	role      string
+	port      uint16
+	ratio     float32
+	flag      bool
+	timestamp time.Time
+)
+
+type audit struct {
+	CreatedBy string    `validate:"min:1"`
+	CreatedAt time.Time `validate:"required"`
+}
+
+type Meta struct {
+	Version int `validate:"min:1"`
+}
+
+type lineItem struct {
+	Quantity int `validate:"min:1"`
+}
+
+type order struct {
+	Items []lineItem
+}
+
+type account struct {
+	Email string `validate:"min:3"`
+}
+
+type node struct {
+	Value int `validate:"min:1"`
+	Next  *node
+}
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func strPtr(s string) *string {
+	return &s
+}
+
 func TestValidate(t *testing.T) {
 	type args struct {
 		v any
@@ -47,6 +97,81 @@ func TestValidate(t *testing.T) {
 				return errors.Is(err, ErrNotStruct)
 			},
 		},
+		{
+			name: "invalid struct: pointer to string",
+			args: args{
+				v: new(string),
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				return errors.Is(err, ErrNotStruct)
+			},
+		},
+		{
+			name: "invalid struct: nil pointer",
+			args: args{
+				v: (*struct {
+					A int `validate:"min:1"`
+				})(nil),
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				return errors.Is(err, ErrNilPointer)
+			},
+		},
+		{
+			name: "valid pointer to struct",
+			args: args{
+				v: &struct {
+					A int `validate:"min:1"`
+				}{A: 1},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong pointer to struct",
+			args: args{
+				v: &struct {
+					A int `validate:"min:1"`
+				}{A: 0},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				assert.Len(t, err.(ValidationErrors), 1)
+				return true
+			},
+		},
+		{
+			name: "wrong pointer to pointer to struct",
+			args: args{
+				v: func() any {
+					p := &struct {
+						A int `validate:"min:1"`
+					}{A: 0}
+					return &p
+				}(),
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				assert.Len(t, err.(ValidationErrors), 1)
+				return true
+			},
+		},
+		{
+			name: "invalid struct: pointer to nil pointer",
+			args: args{
+				v: func() any {
+					var p *struct {
+						A int `validate:"min:1"`
+					}
+					return &p
+				}(),
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				return errors.Is(err, ErrNilPointer)
+			},
+		},
 		{
 			name: "valid struct with no fields",
 			args: args{
@@ -218,186 +343,2384 @@ func TestValidate(t *testing.T) {
 			},
 		},
 		{
-			name: "valid struct with multiple tags",
+			name: "valid struct with sized int fields",
 			args: args{
 				v: struct {
-					MinMaxInt int    `validate:"min:0;max:10"`
-					MinInInt  int    `validate:"min:0;in:2,4,6"`
-					MaxInInt  int    `validate:"max:10;in:2,4,6"`
-					LenInStr  string `validate:"len:1;in:a,b,c"`
-					MinMaxStr string `validate:"min:0;max:10"`
-					MinInStr  string `validate:"min:0;in:a,b,c"`
-					MaxInStr  string `validate:"max:10;in:a,b,c"`
+					Int8  int8  `validate:"min:-128;max:127"`
+					Int16 int16 `validate:"in:1,2,3"`
+					Int32 int32 `validate:"min:-10;max:10"`
+					Int64 int64 `validate:"min:2147483648;max:9223372036854775807"`
 				}{
-					MinMaxInt: 5,
-					MinInInt:  4,
-					MaxInInt:  4,
-					MinMaxStr: "abc",
-					MinInStr:  "b",
-					MaxInStr:  "b",
-					LenInStr:  "b",
+					Int8:  -128,
+					Int16: 2,
+					Int32: 10,
+					Int64: 1 << 40,
 				},
 			},
 			wantErr: false,
 		},
 		{
-			name: "single wrong tag",
+			name: "wrong sized int fields",
 			args: args{
 				v: struct {
-					MinInt int    `validate:"min:5;max:10;in:2,4,12"`
-					MaxInt int    `validate:"min:5;max:10;in:2,4,12"`
-					InInt  int    `validate:"min:5;max:10;in:2,4,12"`
-					LenStr string `validate:"len:2;in:a,b,c"`
-					MinStr string `validate:"min:2;max:10;in:a,b,c"`
-					MaxStr string `validate:"min:0;max:1;in:aa,bb,cc"`
-					InStr  string `validate:"min:1;max:10;in:a,b,c"`
+					Int8     int8  `validate:"min:0"`
+					Int16    int16 `validate:"in:1,2,3"`
+					Int32    int32 `validate:"max:10"`
+					Int64Min int64 `validate:"min:4294967296"`
+					Int64Max int64 `validate:"max:2147483648"`
+					Int64In  int64 `validate:"in:4294967296,8589934592"`
 				}{
-					MinInt: 2,
-					MaxInt: 12,
-					InInt:  4,
-					LenStr: "a",
-					MinStr: "a",
-					MaxStr: "bb",
-					InStr:  "gg",
+					Int8:     -1,
+					Int16:    4,
+					Int32:    11,
+					Int64Min: 4294967295,
+					Int64Max: 2147483649,
+					Int64In:  4294967297,
 				},
 			},
 			wantErr: true,
 			checkErr: func(err error) bool {
-				assert.Len(t, err.(ValidationErrors), 7)
+				assert.Len(t, err.(ValidationErrors), 6)
 				return true
 			},
 		},
 		{
-			name: "multiple wrong tags",
+			name: "valid struct with uint fields",
 			args: args{
 				v: struct {
-					MinInInt int    `validate:"min:5;max:10;in:2,4,12"`
-					MaxInInt int    `validate:"min:5;max:10;in:2,4,12"`
-					LenInStr string `validate:"len:2;in:a,b,c"`
-					MinInStr string `validate:"min:2;max:10;in:a,b,c"`
-					MaxInStr string `validate:"min:0;max:1;in:aa,bb,cc"`
+					Uint   uint   `validate:"min:1;max:10"`
+					Uint8  uint8  `validate:"in:1,2,255"`
+					Uint32 uint32 `validate:"max:4294967295"`
+					Uint64 uint64 `validate:"min:9223372036854775808"`
 				}{
-					MinInInt: 1,
-					MaxInInt: 14,
-					LenInStr: "g",
-					MinInStr: "g",
-					MaxInStr: "gg",
+					Uint:   10,
+					Uint8:  255,
+					Uint32: 4294967295,
+					Uint64: math.MaxUint64,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong uint fields",
+			args: args{
+				v: struct {
+					Uint      uint   `validate:"min:1"`
+					Uint16    uint16 `validate:"in:1,2"`
+					Uint64Max uint64 `validate:"max:9223372036854775807"`
+					Uint64Min uint64 `validate:"min:18446744073709551615"`
+					Negative  uint   `validate:"min:-1"`
+					NegIn     uint   `validate:"in:1,-2"`
+				}{
+					Uint:      0,
+					Uint16:    3,
+					Uint64Max: math.MaxInt64 + 1,
+					Uint64Min: math.MaxUint64 - 1,
 				},
 			},
 			wantErr: true,
 			checkErr: func(err error) bool {
-				assert.Len(t, err.(ValidationErrors), 10)
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 6)
+				assert.ErrorIs(t, errs[4].Err, ErrInvalidValidatorSyntax)
+				assert.ErrorIs(t, errs[5].Err, ErrInvalidValidatorSyntax)
 				return true
 			},
 		},
 		{
-			name: "valid struct with nested",
+			name: "valid struct with float fields",
 			args: args{
 				v: struct {
-					A      int    `validate:"min:0;max:5"`
-					B      string `validate:"len:2"`
-					Nested struct {
-						A int    `validate:"min:0;max:5"`
-						B string `validate:"len:2"`
-					}
+					Price   float64 `validate:"min:0.01;max:9999.99"`
+					Ratio   float32 `validate:"min:-1;max:1"`
+					InFloat float64 `validate:"in:0.5,1.5,2.5"`
 				}{
-					A: 2,
-					B: "bb",
-					Nested: struct {
-						A int    `validate:"min:0;max:5"`
-						B string `validate:"len:2"`
-					}{
-						A: 2,
-						B: "bb",
-					},
+					Price:   0.01,
+					Ratio:   0.5,
+					InFloat: 1.5,
 				},
 			},
 			wantErr: false,
 		},
 		{
-			name: "struct with wrong nested",
+			name: "wrong float fields",
 			args: args{
 				v: struct {
-					A      int    `validate:"min:0;max:5"`
-					B      string `validate:"len:2"`
-					Nested struct {
-						A int    `validate:"min:0;max:5"`
-						B string `validate:"len:2"`
-					}
+					Min     float64 `validate:"min:0.01"`
+					Max     float32 `validate:"max:9999.99"`
+					In      float64 `validate:"in:0.5,1.5"`
+					BadSpec float64 `validate:"min:1.2.3"`
+					BadIn   float64 `validate:"in:0.5,abc"`
 				}{
-					A: 2,
-					B: "bb",
-					Nested: struct {
-						A int    `validate:"min:0;max:5"`
-						B string `validate:"len:2"`
-					}{
-						A: 10,
-						B: "bbb",
-					},
+					Min: 0.009,
+					Max: 10000,
+					In:  1.50001,
 				},
 			},
 			wantErr: true,
 			checkErr: func(err error) bool {
-				assert.Len(t, err.(ValidationErrors), 2)
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 5)
+				assert.ErrorIs(t, errs[3].Err, ErrInvalidValidatorSyntax)
+				assert.ErrorIs(t, errs[4].Err, ErrInvalidValidatorSyntax)
 				return true
 			},
 		},
 		{
-			name: "struct with double nested",
+			name: "valid struct with bool fields",
 			args: args{
 				v: struct {
-					OuterNested struct {
-						InnerNested struct {
-							A int `validate:"min:-1;max:1"`
-						}
-					}
-				}{},
+					AcceptedTerms bool `validate:"eq:true"`
+					Banned        bool `validate:"eq:false"`
+					Any           bool `validate:"in:true,false"`
+				}{
+					AcceptedTerms: true,
+				},
 			},
 			wantErr: false,
 		},
 		{
-			name: "struct with wrong double nested",
+			name: "wrong bool fields",
 			args: args{
 				v: struct {
-					OuterNested struct {
-						InnerNested struct {
-							A int `validate:"min:1;max:2"`
-						}
-					}
-				}{},
+					AcceptedTerms bool `validate:"eq:true"`
+					Banned        bool `validate:"eq:false"`
+					In            bool `validate:"in:true"`
+					BadSpec       bool `validate:"eq:1"`
+					BadIn         bool `validate:"in:true,yes"`
+					BadRule       bool `validate:"min:1"`
+				}{
+					Banned: true,
+				},
 			},
 			wantErr: true,
 			checkErr: func(err error) bool {
-				assert.Len(t, err.(ValidationErrors), 1)
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 6)
+				for _, e := range errs[3:] {
+					assert.ErrorIs(t, e.Err, ErrInvalidValidatorSyntax)
+				}
 				return true
 			},
 		},
 		{
-			name: "struct with unexported nested",
+			name: "valid struct with slice fields",
 			args: args{
 				v: struct {
-					nested struct {
-						A int `validate:"min:0;max:5"`
-					}
-				}{},
+					Tags  []string `validate:"in:red,green,blue"`
+					Ports []int    `validate:"min:1;max:65535"`
+					Empty []int    `validate:"min:1"`
+					Nil   []string `validate:"len:2"`
+				}{
+					Tags:  []string{"red", "blue"},
+					Ports: []int{1, 8080, 65535},
+					Empty: []int{},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong slice fields",
+			args: args{
+				v: struct {
+					Tags    []string `validate:"in:red,green,blue"`
+					Ports   []int    `validate:"min:1;max:65535"`
+					BadSpec []int    `validate:"min:a"`
+				}{
+					Tags:  []string{"red", "black", "blue", "white"},
+					Ports: []int{0, 8080, 65536},
+				},
 			},
 			wantErr: true,
 			checkErr: func(err error) bool {
-				assert.Len(t, err.(ValidationErrors), 1)
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 5)
+				assert.Equal(t, "Tags[1]", errs[0].Field)
+				assert.Equal(t, "Tags[3]", errs[1].Field)
+				assert.Equal(t, "Ports[0]", errs[2].Field)
+				assert.Equal(t, "Ports[2]", errs[3].Field)
+				assert.ErrorIs(t, errs[4].Err, ErrInvalidValidatorSyntax)
 				return true
 			},
 		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			err := Validate(tt.args.v)
-			if tt.wantErr {
-				assert.Error(t, err)
-				assert.True(t, tt.checkErr(err), "test expect an error, but got wrong error type")
-			} else {
+		{
+			name: "valid struct with slice length fields",
+			args: args{
+				v: struct {
+					Recipients []string `validate:"minitems:1;maxitems:10;min:3"`
+					Pair       []int    `validate:"lenitems:2"`
+					Nil        []int    `validate:"maxitems:1"`
+				}{
+					Recipients: []string{"alice", "bob"},
+					Pair:       []int{1, 2},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong slice length fields",
+			args: args{
+				v: struct {
+					Empty   []string `validate:"minitems:1"`
+					Nil     []string `validate:"minitems:1"`
+					TooMany []string `validate:"maxitems:2;min:3"`
+					Pair    []int    `validate:"lenitems:2"`
+					BadSpec []int    `validate:"maxitems:-"`
+				}{
+					Empty:   []string{},
+					TooMany: []string{"alice", "bob", "eve"},
+					Pair:    []int{1},
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 5)
+				assert.ErrorIs(t, errs[4].Err, ErrInvalidValidatorSyntax)
+				return true
+			},
+		},
+		{
+			name: "valid struct with array fields",
+			args: args{
+				v: struct {
+					Checksum [4]byte   `validate:"len:4;dive;min:1"`
+					Codes    [3]string `validate:"min:3;max:3;dive;len:2"`
+				}{
+					Checksum: [4]byte{1, 2, 3, 4},
+					Codes:    [3]string{"ab", "cd", "ef"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong array fields",
+			args: args{
+				v: struct {
+					Checksum [4]byte   `validate:"len:32;dive;min:1"`
+					Codes    [3]string `validate:"in:ab,cd"`
+				}{
+					Checksum: [4]byte{1, 0, 3, 0},
+					Codes:    [3]string{"ab", "ef", "cd"},
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 4)
+				assert.Equal(t, "Checksum[1]", errs[1].Field)
+				assert.Equal(t, "Checksum[3]", errs[2].Field)
+				assert.Equal(t, "Codes[1]", errs[3].Field)
+				return true
+			},
+		},
+		{
+			name: "valid struct with bytes fields",
+			args: args{
+				v: struct {
+					Signature []byte `validate:"len:4"`
+					Payload   []byte `validate:"min:1;max:8"`
+					Nil       []byte `validate:"max:0"`
+					Hex       []byte `validate:"hex"`
+					Base64    []byte `validate:"base64"`
+				}{
+					Signature: []byte{1, 2, 3, 4},
+					Payload:   []byte("abc"),
+					Hex:       []byte("deadbeef"),
+					Base64:    []byte("aGVsbG8="),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong bytes fields",
+			args: args{
+				v: struct {
+					Signature []byte `validate:"len:64"`
+					Payload   []byte `validate:"min:1;max:2"`
+					Nil       []byte `validate:"min:1"`
+					Hex       []byte `validate:"hex"`
+					Base64    []byte `validate:"base64"`
+					Elem      []byte `validate:"in:1,2"`
+					Items     []byte `validate:"maxitems:2"`
+				}{
+					Signature: []byte{1, 2, 3, 4},
+					Payload:   []byte("abc"),
+					Hex:       []byte("xyz"),
+					Base64:    []byte("aGVsbG8"),
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 7)
+				assert.ErrorIs(t, errs[5].Err, ErrInvalidValidatorSyntax)
+				assert.ErrorIs(t, errs[6].Err, ErrInvalidValidatorSyntax)
+				return true
+			},
+		},
+		{
+			name: "valid struct with sql null fields",
+			args: args{
+				v: struct {
+					Name     sql.NullString  `validate:"min:2;max:10"`
+					Age      sql.NullInt64   `validate:"min:18"`
+					Score    sql.NullFloat64 `validate:"max:1"`
+					Active   sql.NullBool    `validate:"eq:true"`
+					Unset    sql.NullString  `validate:"min:2"`
+					Required sql.NullInt32   `validate:"required;in:1,2"`
+				}{
+					Name:     sql.NullString{String: "alice", Valid: true},
+					Age:      sql.NullInt64{Int64: 20, Valid: true},
+					Score:    sql.NullFloat64{Float64: 0.5, Valid: true},
+					Active:   sql.NullBool{Bool: true, Valid: true},
+					Unset:    sql.NullString{String: "a"},
+					Required: sql.NullInt32{Int32: 2, Valid: true},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong sql null fields",
+			args: args{
+				v: struct {
+					Name     sql.NullString  `validate:"min:2;max:3"`
+					Age      sql.NullInt64   `validate:"min:18"`
+					Score    sql.NullFloat64 `validate:"max:1"`
+					Required sql.NullString  `validate:"required"`
+					BadSpec  sql.NullInt64   `validate:"len:1"`
+				}{
+					Name:     sql.NullString{String: "alice", Valid: true},
+					Age:      sql.NullInt64{Int64: 17, Valid: true},
+					Score:    sql.NullFloat64{Float64: 1.5, Valid: true},
+					Required: sql.NullString{String: "set but invalid"},
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 5)
+				assert.ErrorIs(t, errs[4].Err, ErrInvalidValidatorSyntax)
+				return true
+			},
+		},
+		{
+			name: "valid struct with json fields",
+			args: args{
+				v: struct {
+					Config string          `validate:"json"`
+					Extra  json.RawMessage `validate:"json:object"`
+					List   string          `validate:"json:array"`
+					Scalar []byte          `validate:"json"`
+				}{
+					Config: `"text"`,
+					Extra:  json.RawMessage(` {"a": 1}`),
+					List:   `[1, 2]`,
+					Scalar: []byte(`42`),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong json fields",
+			args: args{
+				v: struct {
+					Config  string          `validate:"json"`
+					Empty   string          `validate:"json"`
+					Extra   json.RawMessage `validate:"json:object"`
+					List    string          `validate:"json:array"`
+					Nil     json.RawMessage `validate:"json"`
+					BadSpec string          `validate:"json:number"`
+				}{
+					Config: `{"a":`,
+					Extra:  json.RawMessage(`[1]`),
+					List:   `{}`,
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 6)
+				assert.ErrorIs(t, errs[5].Err, ErrInvalidValidatorSyntax)
+				return true
+			},
+		},
+		{
+			name: "valid struct with regexp fields",
+			args: args{
+				v: struct {
+					Username string `validate:"regexp:^[a-z0-9_-]{3,16}$"`
+					Address  string `validate:"regexp:^[a-z]+:\\d+$;min:3"`
+					Pair     string `validate:"regexp:^a\\;b$"`
+				}{
+					Username: "john_doe",
+					Address:  "localhost:8080",
+					Pair:     "a;b",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong regexp fields",
+			args: args{
+				v: struct {
+					Username string `validate:"regexp:^[a-z0-9_-]{3,16}$"`
+					Address  string `validate:"regexp:^[a-z]+:\\d+$"`
+					BadSpec  string `validate:"regexp:[a-"`
+					Empty    string `validate:"regexp:"`
+				}{
+					Username: "John Doe",
+					Address:  "localhost:http",
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 4)
+				assert.Equal(t, "Username: John Doe does not match ^[a-z0-9_-]{3,16}$", errs[0].Error())
+				assert.ErrorIs(t, errs[2].Err, ErrInvalidValidatorSyntax)
+				assert.ErrorIs(t, errs[3].Err, ErrInvalidValidatorSyntax)
+				return true
+			},
+		},
+		{
+			name: "valid struct with map fields",
+			args: args{
+				v: struct {
+					Labels map[string]string `validate:"min:1;dive;min:1;max:5"`
+					Limits map[string]int    `validate:"len:2;dive;min:0;max:100"`
+					Nil    map[string]int    `validate:"max:2;dive;min:1"`
+				}{
+					Labels: map[string]string{"env": "prod", "team": "core"},
+					Limits: map[string]int{"cpu": 4, "mem": 100},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong map fields",
+			args: args{
+				v: struct {
+					Labels map[string]string `validate:"dive;max:3"`
+					Limits map[string]int    `validate:"in:1,2"`
+					Nil    map[string]int    `validate:"min:1"`
+					Codes  map[int]string    `validate:"len:1"`
+				}{
+					Labels: map[string]string{"env": "prod", "team": "platform"},
+					Limits: map[string]int{"cpu": 4, "mem": 2, "disk": 3},
+					Codes:  map[int]string{1: "a", 2: "b"},
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 6)
+				assert.Equal(t, "Labels[env]", errs[0].Field)
+				assert.Equal(t, "Labels[team]", errs[1].Field)
+				assert.Equal(t, "Limits[cpu]", errs[2].Field)
+				assert.Equal(t, "Limits[disk]", errs[3].Field)
+				return true
+			},
+		},
+		{
+			name: "valid struct with time fields",
+			args: args{
+				v: struct {
+					CreatedAt time.Time  `validate:"required;after:2020-01-01T00:00:00Z;before:now"`
+					Birthday  time.Time  `validate:"min:1900-01-01T00:00:00Z;max:2020-01-01T00:00:00Z"`
+					ExpiresAt *time.Time `validate:"after:now"`
+					Optional  time.Time
+				}{
+					CreatedAt: time.Date(2021, 5, 1, 12, 0, 0, 0, time.UTC),
+					Birthday:  time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong time fields",
+			args: args{
+				v: struct {
+					Zero      time.Time `validate:"required"`
+					CreatedAt time.Time `validate:"after:2020-01-01T00:00:00Z"`
+					Birthday  time.Time `validate:"min:1900-01-01T00:00:00Z;max:2020-01-01T00:00:00Z"`
+					Future    time.Time `validate:"before:now"`
+					BadSpec   time.Time `validate:"after:2020-01-01"`
+					BadRule   time.Time `validate:"len:1"`
+				}{
+					CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+					Birthday:  time.Date(2020, 1, 1, 0, 0, 1, 0, time.UTC),
+					Future:    time.Now().Add(time.Hour),
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 6)
+				assert.ErrorIs(t, errs[4].Err, ErrInvalidValidatorSyntax)
+				assert.ErrorIs(t, errs[5].Err, ErrInvalidValidatorSyntax)
+				return true
+			},
+		},
+		{
+			name: "valid struct with duration fields",
+			args: args{
+				v: struct {
+					Timeout  time.Duration `validate:"min:1s;max:5m"`
+					Legacy   time.Duration `validate:"min:1000;max:2000"`
+					Offset   time.Duration `validate:"min:-1h;max:-1m"`
+					Interval time.Duration `validate:"in:1s,1m,1h"`
+				}{
+					Timeout:  5 * time.Minute,
+					Legacy:   1500,
+					Offset:   -30 * time.Minute,
+					Interval: time.Minute,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong duration fields",
+			args: args{
+				v: struct {
+					Min     time.Duration `validate:"min:1s"`
+					Max     time.Duration `validate:"max:5m"`
+					Legacy  time.Duration `validate:"max:1000"`
+					Offset  time.Duration `validate:"max:-1m"`
+					BadSpec time.Duration `validate:"min:1x"`
+				}{
+					Min:    999 * time.Millisecond,
+					Max:    5*time.Minute + 1,
+					Legacy: 1001,
+					Offset: -59 * time.Second,
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 5)
+				assert.ErrorIs(t, errs[4].Err, ErrInvalidValidatorSyntax)
+				return true
+			},
+		},
+		{
+			name: "valid struct with defined type fields",
+			args: args{
+				v: struct {
+					ID        userID    `validate:"min:1;max:100;in:1,2,42"`
+					Role      role      `validate:"len:5;min:4;max:6;in:admin,guest"`
+					Port      port      `validate:"min:1;max:65535;in:80,443"`
+					Ratio     ratio     `validate:"min:0;max:1;in:0.5"`
+					Flag      flag      `validate:"eq:true;in:true"`
+					Roles     []role    `validate:"minitems:1;in:admin,guest"`
+					CreatedAt timestamp `validate:"required;after:2020-01-01T00:00:00Z;before:now"`
+					RoleRef   *role     `validate:"required;in:admin"`
+				}{
+					ID:        42,
+					Role:      "admin",
+					Port:      443,
+					Ratio:     0.5,
+					Flag:      true,
+					Roles:     []role{"guest"},
+					CreatedAt: timestamp(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+					RoleRef:   func() *role { r := role("admin"); return &r }(),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong defined type fields",
+			args: args{
+				v: struct {
+					ID        userID    `validate:"min:1;max:100;in:1,2,42"`
+					Role      role      `validate:"len:5;min:4;max:6;in:admin,guest"`
+					Port      port      `validate:"min:1;max:65535;in:80,443"`
+					Ratio     ratio     `validate:"min:0;max:1;in:0.5"`
+					Flag      flag      `validate:"eq:true;in:true"`
+					Roles     []role    `validate:"minitems:2;in:admin,guest"`
+					CreatedAt timestamp `validate:"required;after:2020-01-01T00:00:00Z;before:now"`
+				}{
+					ID:    101,
+					Role:  "blocked",
+					Port:  0,
+					Ratio: 1.5,
+					Flag:  false,
+					Roles: []role{"root"},
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				assert.Len(t, err.(ValidationErrors), 15)
+				return true
+			},
+		},
+		{
+			name: "valid struct with multiple tags",
+			args: args{
+				v: struct {
+					MinMaxInt int    `validate:"min:0;max:10"`
+					MinInInt  int    `validate:"min:0;in:2,4,6"`
+					MaxInInt  int    `validate:"max:10;in:2,4,6"`
+					LenInStr  string `validate:"len:1;in:a,b,c"`
+					MinMaxStr string `validate:"min:0;max:10"`
+					MinInStr  string `validate:"min:0;in:a,b,c"`
+					MaxInStr  string `validate:"max:10;in:a,b,c"`
+				}{
+					MinMaxInt: 5,
+					MinInInt:  4,
+					MaxInInt:  4,
+					MinMaxStr: "abc",
+					MinInStr:  "b",
+					MaxInStr:  "b",
+					LenInStr:  "b",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "single wrong tag",
+			args: args{
+				v: struct {
+					MinInt int    `validate:"min:5;max:10;in:2,4,12"`
+					MaxInt int    `validate:"min:5;max:10;in:2,4,12"`
+					InInt  int    `validate:"min:5;max:10;in:2,4,12"`
+					LenStr string `validate:"len:2;in:a,b,c"`
+					MinStr string `validate:"min:2;max:10;in:a,b,c"`
+					MaxStr string `validate:"min:0;max:1;in:aa,bb,cc"`
+					InStr  string `validate:"min:1;max:10;in:a,b,c"`
+				}{
+					MinInt: 2,
+					MaxInt: 12,
+					InInt:  4,
+					LenStr: "a",
+					MinStr: "a",
+					MaxStr: "bb",
+					InStr:  "gg",
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				assert.Len(t, err.(ValidationErrors), 7)
+				return true
+			},
+		},
+		{
+			name: "multiple wrong tags",
+			args: args{
+				v: struct {
+					MinInInt int    `validate:"min:5;max:10;in:2,4,12"`
+					MaxInInt int    `validate:"min:5;max:10;in:2,4,12"`
+					LenInStr string `validate:"len:2;in:a,b,c"`
+					MinInStr string `validate:"min:2;max:10;in:a,b,c"`
+					MaxInStr string `validate:"min:0;max:1;in:aa,bb,cc"`
+				}{
+					MinInInt: 1,
+					MaxInInt: 14,
+					LenInStr: "g",
+					MinInStr: "g",
+					MaxInStr: "gg",
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				assert.Len(t, err.(ValidationErrors), 10)
+				return true
+			},
+		},
+		{
+			name: "valid struct with nested",
+			args: args{
+				v: struct {
+					A      int    `validate:"min:0;max:5"`
+					B      string `validate:"len:2"`
+					Nested struct {
+						A int    `validate:"min:0;max:5"`
+						B string `validate:"len:2"`
+					}
+				}{
+					A: 2,
+					B: "bb",
+					Nested: struct {
+						A int    `validate:"min:0;max:5"`
+						B string `validate:"len:2"`
+					}{
+						A: 2,
+						B: "bb",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "struct with wrong nested",
+			args: args{
+				v: struct {
+					A      int    `validate:"min:0;max:5"`
+					B      string `validate:"len:2"`
+					Nested struct {
+						A int    `validate:"min:0;max:5"`
+						B string `validate:"len:2"`
+					}
+				}{
+					A: 2,
+					B: "bb",
+					Nested: struct {
+						A int    `validate:"min:0;max:5"`
+						B string `validate:"len:2"`
+					}{
+						A: 10,
+						B: "bbb",
+					},
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				assert.Len(t, err.(ValidationErrors), 2)
+				return true
+			},
+		},
+		{
+			name: "struct with double nested",
+			args: args{
+				v: struct {
+					OuterNested struct {
+						InnerNested struct {
+							A int `validate:"min:-1;max:1"`
+						}
+					}
+				}{},
+			},
+			wantErr: false,
+		},
+		{
+			name: "struct with wrong double nested",
+			args: args{
+				v: struct {
+					OuterNested struct {
+						InnerNested struct {
+							A int `validate:"min:1;max:2"`
+						}
+					}
+				}{},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				assert.Len(t, err.(ValidationErrors), 1)
+				return true
+			},
+		},
+		{
+			name: "struct with wrong pointer nested",
+			args: args{
+				v: struct {
+					Nested *struct {
+						A int `validate:"min:1;max:5"`
+					}
+				}{
+					Nested: &struct {
+						A int `validate:"min:1;max:5"`
+					}{A: 10},
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				assert.Len(t, err.(ValidationErrors), 1)
+				return true
+			},
+		},
+		{
+			name: "struct with nil pointer nested",
+			args: args{
+				v: struct {
+					Nested *struct {
+						A int `validate:"min:1;max:5"`
+					}
+				}{},
+			},
+			wantErr: false,
+		},
+		{
+			name: "struct with required pointer nested",
+			args: args{
+				v: struct {
+					Address  *account `validate:"required"`
+					Optional *account
+					Billing  *account `validate:"required"`
+					BadSpec  *account `validate:"min:1"`
+				}{
+					Billing: &account{},
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 3)
+				assert.Equal(t, "Address: is required", errs[0].Error())
+				assert.Equal(t, "Billing.Email: len of  is less than min allowed 3", errs[1].Error())
+				assert.ErrorIs(t, errs[2].Err, ErrInvalidValidatorSyntax)
+				return true
+			},
+		},
+		{
+			name: "struct with recursive pointer nested",
+			args: args{
+				v: node{Value: 1, Next: &node{Value: 0}},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				assert.Len(t, err.(ValidationErrors), 1)
+				return true
+			},
+		},
+		{
+			name: "valid struct with pointer fields",
+			args: args{
+				v: struct {
+					Age      *int    `validate:"min:18"`
+					Name     *string `validate:"min:2;max:10"`
+					Nil      *int    `validate:"min:18;max:20"`
+					Required *string `validate:"required;in:a,b"`
+				}{
+					Age:      intPtr(20),
+					Name:     strPtr("abc"),
+					Required: strPtr("a"),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong pointer fields",
+			args: args{
+				v: struct {
+					Age      *int    `validate:"min:18"`
+					Name     *string `validate:"min:2;max:10"`
+					Required *int    `validate:"required;min:1"`
+					BadSpec  *string `validate:"required:yes"`
+				}{
+					Age:  intPtr(10),
+					Name: strPtr("a"),
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				assert.Len(t, err.(ValidationErrors), 4)
+				return true
+			},
+		},
+		{
+			name: "valid struct with embedded fields",
+			args: args{
+				v: struct {
+					audit
+					*Meta
+				}{
+					audit: audit{CreatedBy: "admin", CreatedAt: time.Now()},
+					Meta:  &Meta{Version: 1},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "struct with wrong embedded fields",
+			args: args{
+				v: struct {
+					audit
+					Meta
+				}{},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 3)
+				assert.Equal(t, "CreatedBy", errs[0].Field)
+				assert.Equal(t, "CreatedAt", errs[1].Field)
+				assert.Equal(t, "Version", errs[2].Field)
+				return true
+			},
+		},
+		{
+			name: "struct with nil embedded pointer",
+			args: args{
+				v: &struct {
+					*audit
+				}{},
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid struct with interface fields",
+			args: args{
+				v: struct {
+					Payload  any
+					Ptr      any
+					Nil      any
+					Scalar   any
+					Stringer fmt.Stringer
+					private  any
+				}{
+					Payload: Meta{Version: 1},
+					Ptr:     &Meta{Version: 2},
+					Scalar:  0,
+					private: Meta{},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "struct with wrong interface fields",
+			args: args{
+				v: struct {
+					Payload any
+					Ptr     any
+					NilPtr  any
+				}{
+					Payload: Meta{},
+					Ptr: &struct {
+						Name string `validate:"min:1"`
+					}{},
+					NilPtr: (*Meta)(nil),
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 2)
+				assert.Equal(t, "Payload.Version", errs[0].Field)
+				assert.Equal(t, "Ptr.Name", errs[1].Field)
+				return true
+			},
+		},
+		{
+			name: "valid struct with slice of structs",
+			args: args{
+				v: struct {
+					Items  []lineItem `validate:"minitems:1"`
+					Ptrs   []*lineItem
+					Nil    []lineItem
+					Orders [2]order
+				}{
+					Items: []lineItem{{Quantity: 1}, {Quantity: 2}},
+					Ptrs:  []*lineItem{{Quantity: 1}, nil},
+					Orders: [2]order{
+						{Items: []lineItem{{Quantity: 3}}},
+						{},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "struct with wrong slice of structs",
+			args: args{
+				v: struct {
+					Items   []lineItem `validate:"maxitems:1"`
+					Orders  []order
+					BadSpec []lineItem `validate:"min:1"`
+				}{
+					Items: []lineItem{{Quantity: 1}, {Quantity: 0}, {Quantity: 2}, {Quantity: 0}},
+					Orders: []order{
+						{Items: []lineItem{{Quantity: 1}}},
+						{Items: []lineItem{{Quantity: 1}, {Quantity: 0}}},
+					},
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 5)
+				assert.Equal(t, "Items: number of items 4 is higher than max allowed 1", errs[0].Error())
+				assert.Equal(t, "Items[1].Quantity: 0 is less than min allowed 1", errs[1].Error())
+				assert.Equal(t, "Items[3].Quantity: 0 is less than min allowed 1", errs[2].Error())
+				assert.Equal(t, "Orders[1].Items[1].Quantity: 0 is less than min allowed 1", errs[3].Error())
+				assert.ErrorIs(t, errs[4].Err, ErrInvalidValidatorSyntax)
+				return true
+			},
+		},
+		{
+			name: "valid struct with map of structs",
+			args: args{
+				v: struct {
+					Accounts map[string]account `validate:"minitems:1"`
+					Ptrs     map[int]*account
+					Nil      map[string]account
+				}{
+					Accounts: map[string]account{"acme": {Email: "a@acme.io"}},
+					Ptrs:     map[int]*account{1: {Email: "b@acme.io"}, 2: nil},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "struct with wrong map of structs",
+			args: args{
+				v: struct {
+					Accounts map[string]account
+					Ptrs     map[int]*account
+				}{
+					Accounts: map[string]account{"acme": {}, "globex": {Email: "g@globex.io"}, "initech": {}},
+					Ptrs:     map[int]*account{10: {}, 7: {}, 9: {}},
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 5)
+				assert.Equal(t, "Accounts[acme].Email: len of  is less than min allowed 3", errs[0].Error())
+				assert.Equal(t, "Accounts[initech].Email: len of  is less than min allowed 3", errs[1].Error())
+				assert.Equal(t, "Ptrs[7].Email: len of  is less than min allowed 3", errs[2].Error())
+				assert.Equal(t, "Ptrs[9].Email: len of  is less than min allowed 3", errs[3].Error())
+				assert.Equal(t, "Ptrs[10].Email: len of  is less than min allowed 3", errs[4].Error())
+				return true
+			},
+		},
+		{
+			name: "struct with unexported nested",
+			args: args{
+				v: struct {
+					nested struct {
+						A int `validate:"min:0;max:5"`
+					}
+				}{},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				assert.Len(t, err.(ValidationErrors), 1)
+				return true
+			},
+		},
+		{
+			name: "valid struct with between fields",
+			args: args{
+				v: struct {
+					Percent int     `validate:"between:0,100"`
+					Low     int     `validate:"between:-10,-1"`
+					Size    uint    `validate:"between:1,1"`
+					Ratio   float64 `validate:"between:0,1"`
+					Name    string  `validate:"between:2,5"`
+				}{
+					Percent: 100,
+					Low:     -10,
+					Size:    1,
+					Ratio:   0.5,
+					Name:    "Bob",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong between fields",
+			args: args{
+				v: struct {
+					Percent  int     `validate:"between:0,100"`
+					Size     uint8   `validate:"between:1,10"`
+					Ratio    float64 `validate:"between:0,1"`
+					Name     string  `validate:"between:2,5"`
+					Reversed int     `validate:"between:10,1"`
+					Single   int     `validate:"between:1"`
+					Triple   uint    `validate:"between:1,2,3"`
+					NegLen   string  `validate:"between:-1,5"`
+				}{
+					Percent: 101,
+					Ratio:   1.5,
+					Name:    "Alexander",
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 8)
+				assert.Equal(t, "Percent: 101 is not between 0 and 100", errs[0].Error())
+				assert.Equal(t, "Size: 0 is not between 1 and 10", errs[1].Error())
+				assert.Equal(t, "Ratio: 1.5 is not between 0 and 1", errs[2].Error())
+				assert.Equal(t, "Name: len of Alexander is not between 2 and 5", errs[3].Error())
+				for _, e := range errs[4:] {
+					assert.ErrorIs(t, e.Err, ErrInvalidValidatorSyntax)
+				}
+				return true
+			},
+		},
+		{
+			name: "valid struct with ne fields",
+			args: args{
+				v: struct {
+					ID      int   `validate:"ne:-1"`
+					Count   int64 `validate:"ne:0"`
+					Version uint  `validate:"ne:0"`
+				}{
+					ID:      0,
+					Count:   -5,
+					Version: 2,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong ne fields",
+			args: args{
+				v: struct {
+					ID      int  `validate:"ne:-1"`
+					Count   int8 `validate:"ne:0"`
+					Version uint `validate:"ne:0"`
+					Empty   int  `validate:"ne:"`
+					NotInt  int  `validate:"ne:zero"`
+					Neg     uint `validate:"ne:-1"`
+				}{
+					ID: -1,
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 6)
+				assert.Equal(t, "ID: must not equal -1", errs[0].Error())
+				assert.Equal(t, "Count: must not equal 0", errs[1].Error())
+				assert.Equal(t, "Version: must not equal 0", errs[2].Error())
+				for _, e := range errs[3:] {
+					assert.ErrorIs(t, e.Err, ErrInvalidValidatorSyntax)
+				}
+				return true
+			},
+		},
+		{
+			name: "valid struct with eq fields",
+			args: args{
+				v: struct {
+					SchemaVersion int   `validate:"eq:3"`
+					Offset        int64 `validate:"eq:-7"`
+					Kind          uint8 `validate:"eq:1"`
+				}{
+					SchemaVersion: 3,
+					Offset:        -7,
+					Kind:          1,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong eq fields",
+			args: args{
+				v: struct {
+					SchemaVersion int  `validate:"eq:3"`
+					Kind          uint `validate:"eq:1"`
+					Empty         int  `validate:"eq:"`
+					Float         int  `validate:"eq:3.0"`
+				}{
+					SchemaVersion: 2,
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 4)
+				assert.Equal(t, "SchemaVersion: must equal 3, got 2", errs[0].Error())
+				assert.Equal(t, "Kind: must equal 1, got 0", errs[1].Error())
+				assert.ErrorIs(t, errs[2].Err, ErrInvalidValidatorSyntax)
+				assert.ErrorIs(t, errs[3].Err, ErrInvalidValidatorSyntax)
+				return true
+			},
+		},
+		{
+			name: "valid struct with exclusive bound fields",
+			args: args{
+				v: struct {
+					Amount   int     `validate:"gt:0"`
+					Discount int     `validate:"gte:0;lt:100"`
+					Retries  uint    `validate:"lte:5"`
+					Weight   float64 `validate:"gt:0;lte:1.5"`
+				}{
+					Amount:   1,
+					Discount: 0,
+					Retries:  5,
+					Weight:   1.5,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong exclusive bound fields",
+			args: args{
+				v: struct {
+					Amount   int     `validate:"gt:0"`
+					Discount int     `validate:"lt:100"`
+					Retries  uint    `validate:"gte:1"`
+					Weight   float64 `validate:"gt:0"`
+					Ratio    float32 `validate:"lt:1"`
+					Name     string  `validate:"gt:3"`
+					Code     string  `validate:"lte:3"`
+				}{
+					Discount: 100,
+					Ratio:    1,
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 7)
+				assert.Equal(t, "Amount: 0 is not greater than 0", errs[0].Error())
+				assert.Equal(t, "Discount: 100 is not less than 100", errs[1].Error())
+				assert.Equal(t, "Retries: 0 is less than min allowed 1", errs[2].Error())
+				assert.Equal(t, "Weight: 0 is not greater than 0", errs[3].Error())
+				assert.Equal(t, "Ratio: 1 is not less than 1", errs[4].Error())
+				assert.ErrorIs(t, errs[5].Err, ErrInvalidValidatorSyntax)
+				assert.ErrorIs(t, errs[6].Err, ErrInvalidValidatorSyntax)
+				return true
+			},
+		},
+		{
+			name: "valid struct with multipleof fields",
+			args: args{
+				v: struct {
+					Quantity int    `validate:"multipleof:5"`
+					Bitrate  uint32 `validate:"multipleof:64"`
+					Offset   int    `validate:"multipleof:-4"`
+					Zero     int8   `validate:"multipleof:3"`
+				}{
+					Quantity: -15,
+					Bitrate:  128,
+					Offset:   8,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong multipleof fields",
+			args: args{
+				v: struct {
+					Quantity int    `validate:"multipleof:5"`
+					Bitrate  uint32 `validate:"multipleof:64"`
+					Offset   int    `validate:"multipleof:-4"`
+					ZeroSpec int    `validate:"multipleof:0"`
+					Float    int    `validate:"multipleof:1.5"`
+					Str      string `validate:"multipleof:2"`
+					UintNeg  uint   `validate:"multipleof:-4"`
+					UintZero uint16 `validate:"multipleof:0"`
+					UintBig  uint64 `validate:"multipleof:18446744073709551615"`
+				}{
+					Quantity: 7,
+					Bitrate:  100,
+					Offset:   6,
+					UintBig:  1,
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 9)
+				assert.Equal(t, "Quantity: 7 is not a multiple of 5", errs[0].Error())
+				assert.Equal(t, "Bitrate: 100 is not a multiple of 64", errs[1].Error())
+				assert.Equal(t, "Offset: 6 is not a multiple of 4", errs[2].Error())
+				assert.Equal(t, "UintBig: 1 is not a multiple of 18446744073709551615", errs[8].Error())
+				for _, e := range errs[3:8] {
+					assert.ErrorIs(t, e.Err, ErrInvalidValidatorSyntax)
+				}
+				return true
+			},
+		},
+		{
+			name: "valid struct with sign fields",
+			args: args{
+				v: struct {
+					Amount  int     `validate:"positive"`
+					Debt    int64   `validate:"negative"`
+					Balance float64 `validate:"nonnegative"`
+					Count   uint    `validate:"positive"`
+					Zero    int     `validate:"nonnegative"`
+					Tiny    float32 `validate:"positive"`
+				}{
+					Amount:  1,
+					Debt:    -1,
+					Balance: 0,
+					Count:   3,
+					Tiny:    0.001,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong sign fields",
+			args: args{
+				v: struct {
+					Amount  int     `validate:"positive"`
+					Debt    int     `validate:"negative"`
+					Balance float64 `validate:"nonnegative"`
+					Count   uint    `validate:"positive"`
+					NaN     float64 `validate:"nonnegative"`
+					UDebt   uint    `validate:"negative"`
+					Param   int     `validate:"positive:1"`
+					Str     string  `validate:"positive"`
+				}{
+					Balance: -0.5,
+					NaN:     math.NaN(),
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 8)
+				assert.Equal(t, "Amount: must be positive, got 0", errs[0].Error())
+				assert.Equal(t, "Debt: must be negative, got 0", errs[1].Error())
+				assert.Equal(t, "Balance: must be nonnegative, got -0.5", errs[2].Error())
+				assert.Equal(t, "Count: must be positive, got 0", errs[3].Error())
+				assert.Equal(t, "NaN: must be nonnegative, got NaN", errs[4].Error())
+				for _, e := range errs[5:] {
+					assert.ErrorIs(t, e.Err, ErrInvalidValidatorSyntax)
+				}
+				return true
+			},
+		},
+		{
+			name: "valid struct with int not_in fields",
+			args: args{
+				v: struct {
+					Code  int    `validate:"not_in:0,-1,999"`
+					Port  uint16 `validate:"not_in:0,22"`
+					Codes []int  `validate:"not_in:-1"`
+				}{
+					Code:  1,
+					Port:  8080,
+					Codes: []int{0, 1, 2},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong int not_in fields",
+			args: args{
+				v: struct {
+					Code      int    `validate:"not_in:0,-1,999"`
+					Port      uint16 `validate:"not_in:0,22"`
+					Malformed int    `validate:"not_in:1,x"`
+					Empty     int    `validate:"not_in:"`
+				}{
+					Code: -1,
+					Port: 22,
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 4)
+				assert.Equal(t, "Code: -1 is a forbidden value", errs[0].Error())
+				assert.Equal(t, "Port: 22 is a forbidden value", errs[1].Error())
+				assert.ErrorIs(t, errs[2].Err, ErrInvalidValidatorSyntax)
+				assert.ErrorIs(t, errs[3].Err, ErrInvalidValidatorSyntax)
+				return true
+			},
+		},
+		{
+			name: "valid struct with required fields",
+			args: args{
+				v: struct {
+					Name    string            `validate:"required"`
+					Age     int               `validate:"required"`
+					Limit   uint              `validate:"required"`
+					Score   float64           `validate:"required"`
+					Active  bool              `validate:"required"`
+					Tags    []string          `validate:"required"`
+					Labels  map[string]string `validate:"required"`
+					Data    []byte            `validate:"required"`
+					Timeout time.Duration     `validate:"required"`
+					Nick    *string           `validate:"required"`
+				}{
+					Name:    "Bob",
+					Age:     -1,
+					Limit:   1,
+					Score:   0.1,
+					Active:  true,
+					Tags:    []string{},
+					Labels:  map[string]string{"env": "prod"},
+					Data:    []byte{0},
+					Timeout: time.Second,
+					Nick:    strPtr(""),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong required fields",
+			args: args{
+				v: struct {
+					Name    string            `validate:"required"`
+					Age     int               `validate:"required"`
+					Score   float64           `validate:"required"`
+					Tags    []string          `validate:"required"`
+					Labels  map[string]string `validate:"required"`
+					Timeout time.Duration     `validate:"required"`
+					Param   string            `validate:"required:true"`
+				}{},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 7)
+				for _, e := range errs[:6] {
+					assert.Equal(t, e.Field+": is required", e.Error())
+				}
+				assert.ErrorIs(t, errs[6].Err, ErrInvalidValidatorSyntax)
+				return true
+			},
+		},
+		{
+			name: "valid struct with omitempty fields",
+			args: args{
+				v: struct {
+					Nickname string            `validate:"omitempty;min:3;max:20"`
+					Age      int               `validate:"min:18;omitempty"`
+					Rate     *float64          `validate:"omitempty;min:1"`
+					Tags     []string          `validate:"omitempty;minitems:2"`
+					Labels   map[string]string `validate:"omitempty;minitems:1"`
+					Website  string            `validate:"omitempty;url"`
+				}{},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong omitempty fields",
+			args: args{
+				v: struct {
+					Nickname string   `validate:"omitempty;min:3;max:20"`
+					Age      int      `validate:"min:18;omitempty"`
+					Tags     []string `validate:"omitempty;minitems:2"`
+					Both     string   `validate:"omitempty;required"`
+					Param    string   `validate:"omitempty:true"`
+					Twice    string   `validate:"omitempty;omitempty"`
+				}{
+					Nickname: "Al",
+					Age:      17,
+					Tags:     []string{"go"},
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 6)
+				assert.Equal(t, "Nickname: len of Al is less than min allowed 3", errs[0].Error())
+				assert.Equal(t, "Age: 17 is less than min allowed 18", errs[1].Error())
+				assert.Equal(t, "Tags: number of items 1 is less than min allowed 2", errs[2].Error())
+				for _, e := range errs[3:] {
+					assert.ErrorIs(t, e.Err, ErrInvalidValidatorSyntax)
+				}
+				return true
+			},
+		},
+		{
+			name: "valid struct with dive fields",
+			args: args{
+				v: struct {
+					Tags    []string       `validate:"min:1;max:3;dive;min:3;max:10"`
+					Scores  map[string]int `validate:"minitems:1;dive;between:0,100"`
+					Codes   *[]string      `validate:"dive;len:2"`
+					Matrix  [][]int        `validate:"len:2;dive;len:2;dive;min:0"`
+					Aliases []string       `validate:"omitempty;min:1;dive;required"`
+					Ptrs    []*string      `validate:"dive;omitempty;min:2"`
+				}{
+					Tags:   []string{"golang", "api"},
+					Scores: map[string]int{"math": 90, "art": 100},
+					Codes:  &[]string{"en", "de"},
+					Matrix: [][]int{{1, 2}, {0, 3}},
+					Ptrs:   []*string{nil, strPtr("ok")},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong dive fields",
+			args: args{
+				v: struct {
+					Tags     []string       `validate:"min:1;max:3;dive;min:3;max:10"`
+					Empty    []string       `validate:"min:1;dive;min:3"`
+					Scores   map[string]int `validate:"dive;between:0,100"`
+					Matrix   [][]int        `validate:"dive;len:2;dive;min:0"`
+					Scalar   string         `validate:"dive;min:1"`
+					NoRules  []string       `validate:"min:1;dive"`
+					ElemOnly []string       `validate:"url;dive;min:1"`
+					Cross    []string       `validate:"dive;eqfield:Tags"`
+				}{
+					Tags:   []string{"go", "golang", "a very long tag"},
+					Scores: map[string]int{"math": 101, "art": -1},
+					Matrix: [][]int{{1, 2}, {-1}},
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs := err.(ValidationErrors)
+				assert.Len(t, errs, 11)
+				assert.Equal(t, "Tags[0]: len of go is less than min allowed 3", errs[0].Error())
+				assert.Equal(t, "Tags[2]: len of a very long tag is higher than max allowed 10", errs[1].Error())
+				assert.Equal(t, "Empty: number of items 0 is less than min allowed 1", errs[2].Error())
+				assert.Equal(t, "Scores[art]: -1 is not between 0 and 100", errs[3].Error())
+				assert.Equal(t, "Scores[math]: 101 is not between 0 and 100", errs[4].Error())
+				assert.Equal(t, "Matrix[1]: number of items 1 is not equal to 2", errs[5].Error())
+				assert.Equal(t, "Matrix[1][0]: -1 is less than min allowed 0", errs[6].Error())
+				for _, e := range errs[7:] {
+					assert.ErrorIs(t, e.Err, ErrInvalidValidatorSyntax)
+				}
+				return true
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.args.v)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.True(t, tt.checkErr(err), "test expect an error, but got wrong error type")
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+}
+
+type invoice struct {
+	Subtotal int `validate:"min:0"`
+	Discount int `validate:"min:0"`
+}
+
+func (i invoice) ValidateStruct() error {
+	if i.Discount > i.Subtotal {
+		return errors.New("discount cannot exceed subtotal")
+	}
+	return nil
+}
+
+type shipment struct {
+	Weight int
+	Volume int
+}
+
+func (s *shipment) ValidateStruct() error {
+	var errs ValidationErrors
+	if s.Weight <= 0 {
+		errs = append(errs, ValidationError{Field: "Weight", Err: errors.New("must be positive")})
+	}
+	if s.Volume <= 0 {
+		errs = append(errs, ValidationError{Field: "Volume", Err: errors.New("must be positive")})
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+func TestValidateStructHook(t *testing.T) {
+	tests := []struct {
+		name     string
+		v        any
+		wantErrs []string
+	}{
+		{
+			name: "top-level hook passes",
+			v:    invoice{Subtotal: 10, Discount: 5},
+		},
+		{
+			name: "tag and hook errors are both reported",
+			v:    invoice{Subtotal: -1, Discount: 5},
+			wantErrs: []string{
+				"Subtotal: -1 is less than min allowed 0",
+				"discount cannot exceed subtotal",
+			},
+		},
+		{
+			name: "pointer receiver hook on nested fields",
+			v: struct {
+				Invoice  invoice
+				Shipment *shipment
+				Parcels  []shipment
+			}{
+				Invoice:  invoice{Subtotal: 1, Discount: 2},
+				Shipment: &shipment{Weight: 1},
+				Parcels:  []shipment{{Weight: 1, Volume: 1}, {Volume: 1}},
+			},
+			wantErrs: []string{
+				"Invoice: discount cannot exceed subtotal",
+				"Shipment.Volume: must be positive",
+				"Parcels[1].Weight: must be positive",
+			},
+		},
+		{
+			name: "nil pointer is skipped",
+			v: struct {
+				Shipment *shipment
+			}{},
+		},
+		{
+			name: "embedded hook is promoted and called once",
+			v: struct {
+				invoice
+			}{invoice{Subtotal: 1, Discount: 2}},
+			wantErrs: []string{
+				"discount cannot exceed subtotal",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.v)
+			if len(tt.wantErrs) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			errs, ok := err.(ValidationErrors)
+			assert.True(t, ok)
+			assert.Len(t, errs, len(tt.wantErrs))
+			for i, want := range tt.wantErrs {
+				assert.Equal(t, want, errs[i].Error())
+			}
+		})
+	}
+}
+
+type thirdPartyRange struct {
+	From, To int
+}
+
+func TestRegisterStructValidation(t *testing.T) {
+	defer structValidations.Delete(reflect.TypeOf(thirdPartyRange{}))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			RegisterStructValidation(func(r thirdPartyRange) []ValidationError {
+				return []ValidationError{{Field: "From", Err: errors.New("stale registration")}}
+			})
+		}()
+	}
+	wg.Wait()
+
+	RegisterStructValidation(func(r thirdPartyRange) []ValidationError {
+		if r.From > r.To {
+			return []ValidationError{{Field: "From", Err: errors.New("must not exceed To")}}
+		}
+		return nil
+	})
+
+	assert.NoError(t, Validate(thirdPartyRange{From: 1, To: 2}))
+
+	err := Validate(struct {
+		Ranges []thirdPartyRange
+		Limit  *thirdPartyRange
+	}{
+		Ranges: []thirdPartyRange{{1, 2}, {3, 1}},
+		Limit:  &thirdPartyRange{5, 4},
+	})
+	errs, ok := err.(ValidationErrors)
+	assert.True(t, ok)
+	assert.Len(t, errs, 2)
+	assert.Equal(t, "Ranges[1].From: must not exceed To", errs[0].Error())
+	assert.Equal(t, "Limit.From: must not exceed To", errs[1].Error())
+}
+
+func TestNew(t *testing.T) {
+	type user struct {
+		Name string `validate:"min:3"`
+		Age  int    `validate:"between:18,120"`
+	}
+	v := New()
+	assert.NoError(t, v.Validate(user{Name: "Bob", Age: 30}))
+	assert.Equal(t, Validate(user{Name: "Al", Age: 7}), v.Validate(user{Name: "Al", Age: 7}))
+	assert.ErrorIs(t, v.Validate(1), ErrNotStruct)
+	assert.ErrorIs(t, v.Validate((*user)(nil)), ErrNilPointer)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			err := v.Validate(user{Name: "Al", Age: i})
+			errs, ok := err.(ValidationErrors)
+			assert.True(t, ok)
+			assert.Len(t, errs, 2)
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestWithTagName(t *testing.T) {
+	type address struct {
+		City string `check:"min:2"`
+	}
+	type profile struct {
+		Name    string `validate:"min:10" check:"min:3"`
+		Legacy  string `validate:"min:10"`
+		Address address
+		Backup  []address
+	}
+	p := profile{
+		Name:    "Al",
+		Legacy:  "short",
+		Address: address{City: "B"},
+		Backup:  []address{{City: "Rome"}, {City: ""}},
+	}
+
+	err := New(WithTagName("check")).Validate(p)
+	errs, ok := err.(ValidationErrors)
+	assert.True(t, ok)
+	assert.Len(t, errs, 3)
+	assert.Equal(t, "Name: len of Al is less than min allowed 3", errs[0].Error())
+	assert.Equal(t, "Address.City: len of B is less than min allowed 2", errs[1].Error())
+	assert.Equal(t, "Backup[1].City: len of  is less than min allowed 2", errs[2].Error())
+
+	err = Validate(p)
+	errs, ok = err.(ValidationErrors)
+	assert.True(t, ok)
+	assert.Len(t, errs, 2)
+	assert.Equal(t, "Name: len of Al is less than min allowed 10", errs[0].Error())
+	assert.Equal(t, "Legacy: len of short is less than min allowed 10", errs[1].Error())
+}
+
+func TestValidateVar(t *testing.T) {
+	type args struct {
+		v   any
+		tag string
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantErr  bool
+		checkErr func(err error) bool
+	}{
+		{
+			name:    "valid string",
+			args:    args{"https://example.com", "min:3;max:254;url"},
+			wantErr: false,
+		},
+		{
+			name:    "invalid string",
+			args:    args{"ab", "min:3;max:254"},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs, ok := err.(ValidationErrors)
+				return ok && len(errs) == 1 && errs[0].Field == "" &&
+					err.Error() == "len of ab is less than min allowed 3"
+			},
+		},
+		{
+			name:    "invalid int",
+			args:    args{-5, "min:0;max:10"},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs, ok := err.(ValidationErrors)
+				return ok && len(errs) == 1
+			},
+		},
+		{
+			name:    "pointer to value",
+			args:    args{func() *int { i := 20; return &i }(), "max:10"},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs, ok := err.(ValidationErrors)
+				return ok && len(errs) == 1
+			},
+		},
+		{
+			name:    "omitempty skips zero value",
+			args:    args{"", "omitempty;url"},
+			wantErr: false,
+		},
+		{
+			name:    "dive into slice",
+			args:    args{[]string{"ok", "x", "fine"}, "min:1;dive;min:2"},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				errs, ok := err.(ValidationErrors)
+				return ok && len(errs) == 1 && errs[0].Error() == "[1]: len of x is less than min allowed 2"
+			},
+		},
+		{
+			name:    "invalid syntax",
+			args:    args{"abc", "min:"},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				return errors.Is(err, ErrInvalidValidatorSyntax)
+			},
+		},
+		{
+			name:    "cross-field rule",
+			args:    args{"abc", "eqfield:Other"},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				return errors.Is(err, ErrInvalidValidatorSyntax)
+			},
+		},
+		{
+			name:    "nil value",
+			args:    args{nil, "required"},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				return errors.Is(err, ErrUnsupportedType)
+			},
+		},
+		{
+			name:    "unsupported kind",
+			args:    args{struct{ A int }{}, "required"},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				return errors.Is(err, ErrUnsupportedType)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateVar(tt.args.v, tt.args.tag)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.True(t, tt.checkErr(err), "test expect an error, but got wrong error type")
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidationErrorsLookup(t *testing.T) {
+	type user struct {
+		Name  string `validate:"min:3;in:alice,bob"`
+		Email string `validate:"min:5"`
+		Age   int    `validate:"min:18"`
+	}
+	err := Validate(user{Name: "Al", Email: "a@b.com", Age: 7})
+	errs, ok := err.(ValidationErrors)
+	assert.True(t, ok)
+
+	tests := []struct {
+		name   string
+		field  string
+		has    bool
+		errors []string
+	}{
+		{
+			name:   "multiple errors on one field",
+			field:  "Name",
+			has:    true,
+			errors: []string{"len of Al is less than min allowed 3", "Al is not in [alice bob]"},
+		},
+		{
+			name:   "single error",
+			field:  "Age",
+			has:    true,
+			errors: []string{"7 is less than min allowed 18"},
+		},
+		{
+			name:  "valid field",
+			field: "Email",
+		},
+		{
+			name:  "prefix does not match",
+			field: "Nam",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.has, errs.Has(tt.field))
+			var got []string
+			for _, err := range errs.ByField(tt.field) {
+				assert.Equal(t, tt.field, err.Field)
+				got = append(got, err.Err.Error())
+			}
+			assert.Equal(t, tt.errors, got)
+		})
+	}
+
+	assert.Equal(t, []string{"Name", "Age"}, errs.Fields())
+	assert.Empty(t, ValidationErrors{}.Fields())
+	assert.Empty(t, ValidationErrors(nil).ByField("Name"))
+	assert.False(t, ValidationErrors(nil).Has("Name"))
+}
+
+func TestValidationErrorDetails(t *testing.T) {
+	type address struct {
+		Zip string `validate:"len:5"`
+	}
+	type order struct {
+		Name     string   `validate:"min:3"`
+		Status   string   `validate:"in:new,paid"`
+		Tags     []string `validate:"max:4"`
+		Items    []int    `validate:"min:2;dive;gte:1"`
+		Address  address
+		Quantity *int   `validate:"required"`
+		Password string `validate:"eqfield:Confirm"`
+		Confirm  string
+	}
+	err := Validate(order{
+		Name:     "Al",
+		Status:   "lost",
+		Tags:     []string{"ok", "too-long"},
+		Items:    []int{0},
+		Address:  address{Zip: "123"},
+		Password: "secret",
+		Confirm:  "secrets",
+	})
+	errs, ok := err.(ValidationErrors)
+	assert.True(t, ok)
+
+	tests := []struct {
+		field string
+		rule  string
+		param string
+		value string
+		msg   string
+	}{
+		{"Name", "min", "3", "Al", "Name: len of Al is less than min allowed 3"},
+		{"Status", "in", "new,paid", "lost", "Status: lost is not in [new paid]"},
+		{"Tags[1]", "max", "4", "too-long", "Tags[1]: len of too-long is higher than max allowed 4"},
+		{"Items", "min", "2", "[0]", "Items: number of items 1 is less than min allowed 2"},
+		{"Items[0]", "gte", "1", "0", "Items[0]: 0 is less than min allowed 1"},
+		{"Address.Zip", "len", "5", "123", "Address.Zip: len of 123 is not equal to 5"},
+		{"Quantity", "required", "", "", "Quantity: is required"},
+		{"Password", "eqfield", "Confirm", "", "Password: must equal Confirm"},
+	}
+	assert.Len(t, errs, len(tests))
+	for i, tt := range tests {
+		t.Run(tt.msg, func(t *testing.T) {
+			assert.Equal(t, tt.field, errs[i].Field)
+			assert.Equal(t, tt.rule, errs[i].Rule)
+			assert.Equal(t, tt.param, errs[i].Param)
+			assert.Equal(t, tt.value, errs[i].Value)
+			assert.Equal(t, tt.msg, errs[i].Error())
+		})
+	}
+
+	err = ValidateVar(7, "min:10")
+	errs, ok = err.(ValidationErrors)
+	assert.True(t, ok)
+	assert.Equal(t, ValidationError{Rule: "min", Param: "10", Value: "7", Err: errs[0].Err}, errs[0])
+	assert.Equal(t, "7 is less than min allowed 10", err.Error())
+}
+
+func TestValidationErrorsMarshalJSON(t *testing.T) {
+	type address struct {
+		Zip string `validate:"len:5"`
+	}
+	type user struct {
+		Email   string   `validate:"min:5"`
+		Tags    []string `validate:"max:3"`
+		Address address
+		Backup  map[string]address
+	}
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{
+			name: "nested fields",
+			err: Validate(user{
+				Email:   "a@b",
+				Tags:    []string{"ok", "long"},
+				Address: address{Zip: "1"},
+				Backup:  map[string]address{"home": {Zip: "22"}},
+			}),
+			want: `[` +
+				`{"field":"Email","rule":"min","param":"5","error":"len of a@b is less than min allowed 5"},` +
+				`{"field":"Tags[1]","rule":"max","param":"3","error":"len of long is higher than max allowed 3"},` +
+				`{"field":"Address.Zip","rule":"len","param":"5","error":"len of 1 is not equal to 5"},` +
+				`{"field":"Backup[home].Zip","rule":"len","param":"5","error":"len of 22 is not equal to 5"}` +
+				`]`,
+		},
+		{
+			name: "struct-level error",
+			err:  ValidationErrors{{Err: errors.New("discount cannot exceed subtotal")}},
+			want: `[{"field":"","error":"discount cannot exceed subtotal"}]`,
+		},
+		{
+			name: "empty",
+			err:  ValidationErrors(nil),
+			want: `[]`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.err)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, string(data))
+		})
+	}
+
+	data, err := json.Marshal(ValidationError{Field: "Name", Rule: "required", Err: errors.New("is required")})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"field":"Name","rule":"required","error":"is required"}`, string(data))
+}
+
+func TestWithJSONFieldNames(t *testing.T) {
+	type address struct {
+		ZipCode string `json:"zip_code" validate:"len:5"`
+	}
+	type account struct {
+		Email    string    `json:"email,omitempty" validate:"min:5"`
+		Nickname string    `json:",omitempty" validate:"min:3"`
+		Secret   string    `json:"-" validate:"min:8"`
+		Dash     string    `json:"-," validate:"min:2"`
+		Plain    string    `validate:"min:2"`
+		Address  address   `json:"address"`
+		Backup   []address `json:"backup"`
+	}
+	a := account{
+		Email:    "a@b",
+		Nickname: "Al",
+		Secret:   "short",
+		Dash:     "x",
+		Plain:    "y",
+		Address:  address{ZipCode: "123"},
+		Backup:   []address{{ZipCode: "12345"}, {ZipCode: "1"}},
+	}
+
+	tests := []struct {
+		name      string
+		validator *Validator
+		want      []string
+	}{
+		{
+			name:      "json names",
+			validator: New(WithJSONFieldNames()),
+			want: []string{
+				"email: len of a@b is less than min allowed 5",
+				"Nickname: len of Al is less than min allowed 3",
+				"Secret: len of short is less than min allowed 8",
+				"-: len of x is less than min allowed 2",
+				"Plain: len of y is less than min allowed 2",
+				"address.zip_code: len of 123 is not equal to 5",
+				"backup[1].zip_code: len of 1 is not equal to 5",
+			},
+		},
+		{
+			name:      "go names by default",
+			validator: New(),
+			want: []string{
+				"Email: len of a@b is less than min allowed 5",
+				"Nickname: len of Al is less than min allowed 3",
+				"Secret: len of short is less than min allowed 8",
+				"Dash: len of x is less than min allowed 2",
+				"Plain: len of y is less than min allowed 2",
+				"Address.ZipCode: len of 123 is not equal to 5",
+				"Backup[1].ZipCode: len of 1 is not equal to 5",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs, ok := tt.validator.Validate(a).(ValidationErrors)
+			assert.True(t, ok)
+			got := make([]string, 0, len(errs))
+			for _, err := range errs {
+				got = append(got, err.Error())
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	data, err := json.Marshal(New(WithJSONFieldNames()).Validate(account{
+		Email:    "alice@example.com",
+		Nickname: "Alice",
+		Secret:   "long enough",
+		Dash:     "ok",
+		Plain:    "ok",
+		Address:  address{ZipCode: "9"},
+	}))
+	assert.NoError(t, err)
+	assert.Equal(t, `[{"field":"address.zip_code","rule":"len","param":"5","error":"len of 9 is not equal to 5"}]`, string(data))
+}
+
+func TestWithNameTags(t *testing.T) {
+	type signup struct {
+		Email    string `json:"email" form:"user_email" validate:"min:5"`
+		Nickname string `json:"-" form:"nick" validate:"min:3"`
+		Age      int    `yaml:"age" validate:"min:18"`
+		Referrer string `json:",omitempty" form:"ref" validate:"min:2"`
+	}
+	s := signup{Email: "a@b", Nickname: "Al", Age: 7, Referrer: "x"}
+
+	tests := []struct {
+		name      string
+		validator *Validator
+		want      []string
+	}{
+		{
+			name:      "json first",
+			validator: New(WithNameTags("json", "form")),
+			want:      []string{"email", "nick", "Age", "ref"},
+		},
+		{
+			name:      "form first",
+			validator: New(WithNameTags("form", "json")),
+			want:      []string{"user_email", "nick", "Age", "ref"},
+		},
+		{
+			name:      "yaml only",
+			validator: New(WithNameTags("yaml")),
+			want:      []string{"Email", "Nickname", "age", "Referrer"},
+		},
+		{
+			name:      "go names",
+			validator: New(WithNameTags()),
+			want:      []string{"Email", "Nickname", "Age", "Referrer"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i := 0; i < 2; i++ {
+				errs, ok := tt.validator.Validate(s).(ValidationErrors)
+				assert.True(t, ok)
+				assert.Equal(t, tt.want, errs.Fields())
+			}
+		})
+	}
+}
+
+func TestNestedFieldPaths(t *testing.T) {
+	type inner struct {
+		A int `validate:"min:1"`
+	}
+	type nested struct {
+		Inner inner
+		B     string `validate:"len:2"`
+	}
+	type root struct {
+		Nested  nested
+		Pointer *nested
+		C       int `validate:"max:5"`
+	}
+	err := Validate(root{Nested: nested{B: "ab"}, Pointer: &nested{Inner: inner{A: 1}, B: "x"}, C: 6})
+	errs, ok := err.(ValidationErrors)
+	assert.True(t, ok)
+
+	tests := []struct {
+		field string
+		msg   string
+	}{
+		{"Nested.Inner.A", "Nested.Inner.A: 0 is less than min allowed 1"},
+		{"Pointer.B", "Pointer.B: len of x is not equal to 2"},
+		{"C", "C: 6 is higher than max allowed 5"},
+	}
+	assert.Len(t, errs, len(tests))
+	for i, tt := range tests {
+		t.Run(tt.field, func(t *testing.T) {
+			assert.Equal(t, tt.field, errs[i].Field)
+			assert.Equal(t, tt.msg, errs[i].Error())
+			assert.NotEqual(t, ValidationError{}, errs[i].Err)
+			_, wrapped := errs[i].Err.(ValidationError)
+			assert.False(t, wrapped)
+		})
+	}
+	assert.Equal(t, "Nested.Inner.A: 0 is less than min allowed 1\nPointer.B: len of x is not equal to 2\nC: 6 is higher than max allowed 5", err.Error())
+	assert.True(t, errs.Has("Nested.Inner.A"))
+	assert.Equal(t, "min", errs.ByField("Nested.Inner.A")[0].Rule)
+}
+
+func TestIndexedFieldPaths(t *testing.T) {
+	type item struct {
+		Price int `validate:"min:1"`
+	}
+	type account struct {
+		Email string `validate:"min:3"`
+	}
+	type order struct {
+		Items    []item
+		Accounts map[string]account
+		Grid     [][]item
+	}
+	err := Validate(order{
+		Items: []item{{1}, {2}, {0}},
+		Accounts: map[string]account{
+			"acme":     {Email: "a"},
+			"a]b":      {Email: "b"},
+			`say "hi"`: {Email: "c"},
+			"ok":       {Email: "ok@example.com"},
+		},
+		Grid: [][]item{{{1}}, {{1}, {0}}},
+	})
+	errs, ok := err.(ValidationErrors)
+	assert.True(t, ok)
+
+	tests := []struct {
+		field string
+		msg   string
+	}{
+		{"Items[2].Price", "0 is less than min allowed 1"},
+		{`Accounts["a]b"].Email`, "len of b is less than min allowed 3"},
+		{"Accounts[acme].Email", "len of a is less than min allowed 3"},
+		{`Accounts["say \"hi\""].Email`, "len of c is less than min allowed 3"},
+		{"Grid[1][1].Price", "0 is less than min allowed 1"},
+	}
+	assert.Len(t, errs, len(tests))
+	for i, tt := range tests {
+		t.Run(tt.field, func(t *testing.T) {
+			assert.Equal(t, tt.field, errs[i].Field)
+			assert.Equal(t, tt.msg, errs[i].Err.Error())
+			assert.True(t, errs.Has(tt.field))
+		})
+	}
+
+	assert.Len(t, errs.ByFieldPrefix("Items"), 1)
+	assert.Len(t, errs.ByFieldPrefix("Items[2]"), 1)
+	assert.Len(t, errs.ByFieldPrefix("Items[1]"), 0)
+	assert.Len(t, errs.ByFieldPrefix("Item"), 0)
+	assert.Len(t, errs.ByFieldPrefix("Accounts"), 3)
+	assert.Len(t, errs.ByFieldPrefix("Grid[1]"), 1)
+	assert.Len(t, errs.ByFieldPrefix(""), len(tests))
+	assert.Empty(t, ValidationErrors(nil).ByFieldPrefix("Items"))
+}
+
+type cyclicNode struct {
+	Name     string `validate:"min:1"`
+	Next     *cyclicNode
+	Children []*cyclicNode
+	Any      any
+}
+
+func TestCyclicValues(t *testing.T) {
+	self := &cyclicNode{}
+	self.Next = self
+
+	a, b := &cyclicNode{Name: "a"}, &cyclicNode{}
+	a.Next, b.Next = b, a
+
+	shared := &cyclicNode{}
+	tree := &cyclicNode{Name: "root", Children: []*cyclicNode{shared, shared}}
+	shared.Children = []*cyclicNode{tree}
+
+	iface := &cyclicNode{}
+	iface.Any = iface
+
+	tests := []struct {
+		name       string
+		v          any
+		wantFields []string
+	}{
+		{
+			name:       "self reference",
+			v:          self,
+			wantFields: []string{"Name"},
+		},
+		{
+			name:       "two node cycle",
+			v:          a,
+			wantFields: []string{"Next.Name"},
+		},
+		{
+			name:       "shared node reported per path",
+			v:          tree,
+			wantFields: []string{"Children[0].Name", "Children[1].Name"},
+		},
+		{
+			name:       "cycle through interface",
+			v:          iface,
+			wantFields: []string{"Name"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs, ok := Validate(tt.v).(ValidationErrors)
+			assert.True(t, ok)
+			fields := make([]string, 0, len(errs))
+			for _, e := range errs {
+				fields = append(fields, e.Field)
+			}
+			assert.Equal(t, tt.wantFields, fields)
+		})
+	}
+}
+
+func TestValid(t *testing.T) {
+	type config struct {
+		Name string `validate:"min:3"`
+		Port int    `validate:"min:1;max:65535"`
+	}
+	tests := []struct {
+		name string
+		v    any
+		want bool
+	}{
+		{"valid struct", config{Name: "api", Port: 8080}, true},
+		{"valid pointer", &config{Name: "api", Port: 8080}, true},
+		{"invalid struct", config{Name: "a", Port: 0}, false},
+		{"nil pointer", (*config)(nil), false},
+		{"not a struct", "config", false},
+		{"nil", nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Valid(tt.v))
+		})
+	}
+
+	var calls int
+	v := New()
+	assert.NoError(t, v.RegisterValidation("counted", func(reflect.Value, string) error {
+		calls++
+		return errors.New("always fails")
+	}, reflect.Int))
+	type counted struct {
+		A int `validate:"counted"`
+		B int `validate:"counted"`
+		C int `validate:"counted"`
+	}
+	assert.False(t, v.Valid(counted{}))
+	assert.Equal(t, 1, calls)
+	assert.Len(t, v.Validate(counted{}).(ValidationErrors), 3)
+}
+
+func TestMustValidate(t *testing.T) {
+	type config struct {
+		Name string `validate:"min:3"`
+	}
+	assert.NotPanics(t, func() { MustValidate(config{Name: "api"}) })
+	assert.NotPanics(t, func() { New().MustValidate(&config{Name: "api"}) })
+	assert.PanicsWithError(t, "len of a is less than min allowed 3", func() { MustValidate(config{Name: "a"}) })
+	assert.PanicsWithError(t, ErrNilPointer.Error(), func() { MustValidate((*config)(nil)) })
+	assert.PanicsWithError(t, ErrNotStruct.Error(), func() { MustValidate(42) })
+}
+
+func TestCheckTag(t *testing.T) {
+	type nested struct{}
+	type form struct {
+		Name     string
+		Age      int
+		Tags     []string
+		Password string
+		Home     nested
+		secret   string
+	}
+	parent := reflect.TypeOf(form{})
+	tests := []struct {
+		name    string
+		index   int
+		tag     string
+		wantErr error
+		want    string
+	}{
+		{name: "valid", index: 0, tag: "required;min:2"},
+		{name: "valid collection", index: 2, tag: "maxitems:3;dive;max:5"},
+		{name: "valid cross field", index: 0, tag: "nefield:Password"},
+		{name: "syntax", index: 0, tag: ";", wantErr: ErrInvalidValidatorSyntax, want: "invalid validator syntax"},
+		{name: "bad param", index: 0, tag: "len:abcdef", wantErr: ErrInvalidValidatorSyntax, want: "len:abcdef: invalid validator syntax"},
+		{name: "unknown rule", index: 0, tag: "required;emial", wantErr: ErrUnknownRule, want: "emial: unknown rule"},
+		{name: "kind mismatch", index: 1, tag: "len:3", wantErr: ErrRuleNotApplicable, want: "len:3: rule is not applicable to field type"},
+		{name: "dive mismatch", index: 0, tag: "dive;required", wantErr: ErrRuleNotApplicable, want: "dive: rule is not applicable to field type"},
+		{name: "element mismatch", index: 2, tag: "dive;gt:1", wantErr: ErrRuleNotApplicable, want: "gt:1: rule is not applicable to field type"},
+		{name: "unknown sibling", index: 0, tag: "eqfield:Missing", wantErr: ErrInvalidValidatorSyntax, want: "eqfield:Missing: invalid validator syntax"},
+		{name: "untaggable", index: 4, tag: "required", wantErr: ErrRuleNotApplicable, want: "required: rule is not applicable to field type"},
+		{name: "unexported", index: 5, tag: "required", wantErr: ErrValidateForUnexportedFields, want: ErrValidateForUnexportedFields.Error()},
+		{name: "out of range", index: 6, tag: "required", wantErr: ErrUnsupportedType, want: ErrUnsupportedType.Error()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckTag(parent, tt.index, tt.tag)
+			if tt.wantErr == nil {
 				assert.NoError(t, err)
+				return
 			}
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.EqualError(t, err, tt.want)
 		})
 	}
 
+	v := New()
+	assert.NoError(t, v.RegisterValidation("slugged", func(reflect.Value, string) error { return nil }, reflect.String))
+	assert.NoError(t, v.CheckTag(parent, 0, "slugged"))
+	assert.ErrorIs(t, v.CheckTag(parent, 1, "slugged"), ErrRuleNotApplicable)
+	assert.ErrorIs(t, CheckTag(parent, 0, "slugged"), ErrUnknownRule)
 }